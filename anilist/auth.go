@@ -1,43 +1,61 @@
 package anilist
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // GetTokenPath returns the path to the AniList token file
 func GetTokenPath() (string, error) {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	dataDir := filepath.Join(homeDir, ".oni")
-	if err := os.MkdirAll(dataDir, 0755); err != nil {
-		return "", fmt.Errorf("failed to create data directory: %w", err)
-	}
-
-	return filepath.Join(dataDir, "anilist_token.txt"), nil
+	return dataFilePath("anilist_token.txt")
 }
 
 // GetUserIDPath returns the path to the AniList user ID file
 func GetUserIDPath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	return dataFilePath("anilist_user_id.txt")
+}
+
+// dataFilePath returns the path to a file in the data directory, moving it from ~/.oni if needed
+func dataFilePath(name string) (string, error) {
+	dataDir, err := utils.DataDir()
 	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		return "", fmt.Errorf("failed to resolve data directory: %w", err)
 	}
 
-	dataDir := filepath.Join(homeDir, ".oni")
-	return filepath.Join(dataDir, "anilist_user_id.txt"), nil
+	path := filepath.Join(dataDir, name)
+	if err := utils.MigrateLegacyFile(path, name); err != nil {
+		logger.Warn("Failed to migrate AniList file", map[string]interface{}{
+			"path":  path,
+			"error": err.Error(),
+		})
+	}
+	return path, nil
 }
 
-// LoadToken loads the AniList access token from file
+// LoadToken loads the AniList access token from the keyring (if enabled) or file
 func LoadToken() (string, error) {
-	logger.Debug("Loading AniList token", nil)
+	logger.Debug("Loading AniList token", map[string]interface{}{
+		"keyring": useKeyring,
+	})
+
+	if useKeyring {
+		token, err := keyringGet()
+		if err == nil {
+			logger.Info("AniList token loaded from keyring", nil)
+			return token, nil
+		}
+		if !errors.Is(err, errKeyringNotFound) {
+			logger.Warn("Failed to read token from keyring, falling back to file", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
 
 	tokenPath, err := GetTokenPath()
 	if err != nil {
@@ -62,12 +80,35 @@ func LoadToken() (string, error) {
 		"path": tokenPath,
 	})
 
-	return strings.TrimSpace(string(data)), nil
+	token := strings.TrimSpace(string(data))
+
+	// Move a token saved before the keyring was enabled into it
+	if useKeyring && token != "" {
+		if err := keyringSet(token); err == nil {
+			os.Remove(tokenPath)
+			logger.Info("Moved AniList token from file to keyring", nil)
+		}
+	}
+
+	return token, nil
 }
 
-// SaveToken saves the AniList access token to file
+// SaveToken saves the AniList access token to the keyring (if enabled) or file
 func SaveToken(token string) error {
-	logger.Debug("Saving AniList token", nil)
+	logger.Debug("Saving AniList token", map[string]interface{}{
+		"keyring": useKeyring,
+	})
+
+	if useKeyring {
+		err := keyringSet(token)
+		if err == nil {
+			logger.Info("AniList token saved to keyring", nil)
+			return nil
+		}
+		logger.Warn("Failed to save token to keyring, falling back to file", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
 
 	tokenPath, err := GetTokenPath()
 	if err != nil {
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +17,34 @@ import (
 
 const anilistAPIURL = "https://graphql.anilist.co"
 
+// connectTimeout bounds the connectivity check, so startup with no network doesn't hang
+const connectTimeout = 3 * time.Second
+
+// ErrOffline is returned when AniList can't be reached at all, e.g. with no network
+var ErrOffline = errors.New("can't reach AniList")
+
+// ErrInvalidToken is returned when AniList answers with no data, which usually means the token expired
+var ErrInvalidToken = errors.New("token may be invalid")
+
+// ErrRateLimited is returned when AniList rejects a request for exceeding its rate limit
+var ErrRateLimited = errors.New("rate limited by AniList")
+
+// defaultRetryAfter is how long to back off when a rate limit response has no Retry-After header
+const defaultRetryAfter = 60 * time.Second
+
+// RateLimitError carries how long AniList asked us to wait; it matches ErrRateLimited with errors.Is
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%v, retry in %s", ErrRateLimited, e.RetryAfter)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
 // Client represents an AniList API client
 type Client struct {
 	httpClient  *http.Client
@@ -27,17 +57,10 @@ func NewClient() (*Client, error) {
 	logger.Debug("Creating new AniList client", nil)
 
 	// Configure HTTP client with timeout and connection pooling
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-	}
-
 	client := &Client{
 		httpClient: &http.Client{
 			Timeout:   60 * time.Second,
-			Transport: transport,
+			Transport: newTransport(),
 		},
 	}
 
@@ -98,6 +121,49 @@ func NewClient() (*Client, error) {
 	return client, nil
 }
 
+// newTransport returns the transport AniList requests go through, honouring HTTP_PROXY/HTTPS_PROXY
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+}
+
+// CheckConnection returns ErrOffline when AniList's API can't be reached within a few seconds
+// It makes a request the way the client does, so it goes through a configured proxy too
+func CheckConnection(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, anilistAPIURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpClient := &http.Client{Transport: newTransport()}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		logger.Warn("AniList is unreachable", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return fmt.Errorf("%w: %v", ErrOffline, err)
+	}
+	// Any answer, even an error status for a HEAD request, means AniList can be reached
+	resp.Body.Close()
+	return nil
+}
+
+// NewPublicClient creates a client without a token, for public data such as episode thumbnails
+func NewPublicClient() *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
 // NewClientWithToken creates a new AniList client with the given token
 func NewClientWithToken(token string) (*Client, error) {
 	logger.Debug("Creating new AniList client with provided token", nil)
@@ -107,7 +173,7 @@ func NewClientWithToken(token string) (*Client, error) {
 		accessToken: token,
 	}
 
-	// Fetch user ID
+	// Fetching the user ID is also what checks the token, so it always goes to AniList here
 	userID, err := client.fetchUserID(context.Background())
 	if err != nil {
 		logger.Error("Failed to fetch user ID with provided token", err, nil)
@@ -189,6 +255,10 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]i
 			"query": queryName,
 			"url":   anilistAPIURL,
 		})
+		// A request that never got an answer means the network or AniList is down, unless it was called off
+		if ctx.Err() == nil {
+			return fmt.Errorf("failed to execute request: %w: %w", ErrOffline, err)
+		}
 		return fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
@@ -202,6 +272,18 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]i
 		return fmt.Errorf("failed to read response: %w", err)
 	}
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter := defaultRetryAfter
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		logger.Warn("AniList rate limit hit", map[string]interface{}{
+			"query":      queryName,
+			"retryAfter": retryAfter.String(),
+		})
+		return &RateLimitError{RetryAfter: retryAfter}
+	}
+
 	var gqlResp graphqlResponse
 	if err := json.Unmarshal(body, &gqlResp); err != nil {
 		logger.Error("Failed to unmarshal GraphQL response", err, map[string]interface{}{
@@ -237,7 +319,7 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]i
 			"query":      queryName,
 			"statusCode": resp.StatusCode,
 		})
-		return fmt.Errorf("empty response from API - token may be invalid [HTTP %d]", resp.StatusCode)
+		return fmt.Errorf("empty response from API - %w [HTTP %d]", ErrInvalidToken, resp.StatusCode)
 	}
 
 	if err := json.Unmarshal(gqlResp.Data, result); err != nil {
@@ -265,9 +347,28 @@ func (c *Client) fetchUserID(ctx context.Context) (int, error) {
 	return result.Viewer.ID, nil
 }
 
+// GetScoreFormat returns the score format the user has set on AniList (e.g. POINT_100, POINT_5)
+func (c *Client) GetScoreFormat(ctx context.Context) (string, error) {
+	var result ScoreFormatResponse
+	if err := c.query(ctx, GetScoreFormatQuery, nil, &result); err != nil {
+		return "", err
+	}
+
+	return result.Viewer.MediaListOptions.ScoreFormat, nil
+}
+
 // GetUserID returns the user ID for the authenticated user
+// The ID is only fetched when the client doesn't know it yet, to spare the rate limit
 func (c *Client) GetUserID(ctx context.Context) (int, error) {
-	return c.fetchUserID(ctx)
+	if c.userID != 0 {
+		return c.userID, nil
+	}
+	userID, err := c.fetchUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c.userID = userID
+	return userID, nil
 }
 
 // SearchAnime searches for anime by name
@@ -300,8 +401,45 @@ func (c *Client) SearchAnime(ctx context.Context, search string, showAdult bool)
 	return result.Page.Media, nil
 }
 
+// GetSeasonalAnime gets a page of anime airing in the given season (WINTER, SPRING, SUMMER, FALL) and year
+func (c *Client) GetSeasonalAnime(ctx context.Context, season string, year int, page int, showAdult bool) ([]Anime, bool, error) {
+	logger.Info("Fetching seasonal anime from AniList", map[string]interface{}{
+		"season":    season,
+		"year":      year,
+		"page":      page,
+		"showAdult": showAdult,
+	})
+
+	variables := map[string]interface{}{
+		"season":     season,
+		"seasonYear": year,
+		"page":       page,
+		"perPage":    20,
+	}
+
+	if !showAdult {
+		variables["isAdult"] = false
+	}
+
+	var result SeasonalResponse
+	if err := c.query(ctx, SeasonalAnimeQuery, variables, &result); err != nil {
+		return nil, false, err
+	}
+
+	logger.Info("Seasonal anime fetched", map[string]interface{}{
+		"season":       season,
+		"year":         year,
+		"page":         page,
+		"resultsCount": len(result.Page.Media),
+		"hasNextPage":  result.Page.PageInfo.HasNextPage,
+	})
+
+	return result.Page.Media, result.Page.PageInfo.HasNextPage, nil
+}
+
 // GetAnimeList gets the user's anime list by status
-func (c *Client) GetAnimeList(ctx context.Context, status string) ([]MediaListEntry, error) {
+// Entries on the user's custom lists come back separately, keyed by list name
+func (c *Client) GetAnimeList(ctx context.Context, status string) ([]MediaListEntry, map[string][]MediaListEntry, error) {
 	logger.Info("Fetching anime list from AniList", map[string]interface{}{
 		"userID": c.userID,
 		"status": status,
@@ -318,11 +456,17 @@ func (c *Client) GetAnimeList(ctx context.Context, status string) ([]MediaListEn
 
 	var result ListResponse
 	if err := c.query(ctx, GetAnimeListQuery, variables, &result); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	// An entry on a custom list shows up in that list as well as its status list
 	var entries []MediaListEntry
+	custom := make(map[string][]MediaListEntry)
 	for _, list := range result.MediaListCollection.Lists {
+		if list.IsCustomList {
+			custom[list.Name] = append(custom[list.Name], list.Entries...)
+			continue
+		}
 		entries = append(entries, list.Entries...)
 	}
 
@@ -330,17 +474,29 @@ func (c *Client) GetAnimeList(ctx context.Context, status string) ([]MediaListEn
 		"userID":       c.userID,
 		"status":       status,
 		"entriesCount": len(entries),
+		"customLists":  len(custom),
 	})
 
-	return entries, nil
+	return entries, custom, nil
 }
 
 // UpdateProgress updates the watch progress for an anime
 func (c *Client) UpdateProgress(ctx context.Context, mediaID, progress int, status string) error {
+	return c.updateProgress(ctx, mediaID, progress, status, false)
+}
+
+// UpdateProgressPrivately updates the progress and marks the entry private, hiding it from the public list
+func (c *Client) UpdateProgressPrivately(ctx context.Context, mediaID, progress int, status string) error {
+	return c.updateProgress(ctx, mediaID, progress, status, true)
+}
+
+// updateProgress saves progress and status; private only ever hides the entry, it never makes one public
+func (c *Client) updateProgress(ctx context.Context, mediaID, progress int, status string, private bool) error {
 	logger.Info("Updating anime progress on AniList", map[string]interface{}{
 		"mediaID":  mediaID,
 		"progress": progress,
 		"status":   status,
+		"private":  private,
 	})
 
 	variables := map[string]interface{}{
@@ -348,6 +504,9 @@ func (c *Client) UpdateProgress(ctx context.Context, mediaID, progress int, stat
 		"progress": progress,
 		"status":   status,
 	}
+	if private {
+		variables["private"] = true
+	}
 
 	var result UpdateResponse
 	err := c.query(ctx, UpdateProgressMutation, variables, &result)
@@ -367,6 +526,59 @@ func (c *Client) UpdateProgress(ctx context.Context, mediaID, progress int, stat
 	return nil
 }
 
+// UpdateRewatch records a finished rewatch by setting the progress and the new rewatch count
+// private marks the entry private as well
+func (c *Client) UpdateRewatch(ctx context.Context, mediaID, progress, repeat int, private bool) error {
+	logger.Info("Recording finished rewatch on AniList", map[string]interface{}{
+		"mediaID":  mediaID,
+		"progress": progress,
+		"repeat":   repeat,
+	})
+
+	variables := map[string]interface{}{
+		"mediaId":  mediaID,
+		"progress": progress,
+		"repeat":   repeat,
+	}
+	if private {
+		variables["private"] = true
+	}
+
+	var result UpdateResponse
+	if err := c.query(ctx, UpdateRewatchMutation, variables, &result); err != nil {
+		logger.Error("Failed to record rewatch", err, map[string]interface{}{
+			"mediaID": mediaID,
+			"repeat":  repeat,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// UpdateNotes replaces the notes on an anime's list entry; empty notes clear them
+func (c *Client) UpdateNotes(ctx context.Context, mediaID int, notes string) error {
+	logger.Info("Updating anime notes on AniList", map[string]interface{}{
+		"mediaID": mediaID,
+		"length":  len(notes),
+	})
+
+	variables := map[string]interface{}{
+		"mediaId": mediaID,
+		"notes":   notes,
+	}
+
+	var result UpdateResponse
+	if err := c.query(ctx, UpdateNotesMutation, variables, &result); err != nil {
+		logger.Error("Failed to update anime notes", err, map[string]interface{}{
+			"mediaID": mediaID,
+		})
+		return err
+	}
+
+	return nil
+}
+
 // UpdateScore updates the score for an anime
 func (c *Client) UpdateScore(ctx context.Context, mediaID int, score float64) error {
 	logger.Info("Updating anime score on AniList", map[string]interface{}{
@@ -425,6 +637,27 @@ func (c *Client) UpdateStatus(ctx context.Context, mediaID int, status string) e
 	return nil
 }
 
+// ToggleFavourite adds an anime to the user's favourites, or removes it if it is already there
+func (c *Client) ToggleFavourite(ctx context.Context, mediaID int) error {
+	logger.Info("Toggling anime favourite on AniList", map[string]interface{}{
+		"mediaID": mediaID,
+	})
+
+	variables := map[string]interface{}{
+		"animeId": mediaID,
+	}
+
+	var result json.RawMessage
+	if err := c.query(ctx, ToggleFavouriteMutation, variables, &result); err != nil {
+		logger.Error("Failed to toggle favourite", err, map[string]interface{}{
+			"mediaID": mediaID,
+		})
+		return err
+	}
+
+	return nil
+}
+
 // GetAnimeInfo gets detailed information about an anime
 func (c *Client) GetAnimeInfo(ctx context.Context, mediaID int) (*Anime, error) {
 	logger.Debug("Fetching anime info from AniList", map[string]interface{}{
@@ -454,6 +687,26 @@ func (c *Client) GetAnimeInfo(ctx context.Context, mediaID int) (*Anime, error)
 	return &result.Media, nil
 }
 
+// GetStreamingEpisodes fetches the streaming site episodes AniList lists for an anime
+// Only licensed shows have them, so an empty result is normal
+func (c *Client) GetStreamingEpisodes(ctx context.Context, mediaID int) ([]StreamingEpisode, error) {
+	variables := map[string]interface{}{
+		"id": mediaID,
+	}
+
+	var result struct {
+		Media struct {
+			StreamingEpisodes []StreamingEpisode `json:"streamingEpisodes"`
+		} `json:"Media"`
+	}
+
+	if err := c.query(ctx, StreamingEpisodesQuery, variables, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch streaming episodes: %w", err)
+	}
+
+	return result.Media.StreamingEpisodes, nil
+}
+
 // GetCurrentUserID returns the current user's ID (synchronous, no API call)
 func (c *Client) GetCurrentUserID() int {
 	return c.userID
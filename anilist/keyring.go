@@ -0,0 +1,90 @@
+package anilist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+const (
+	keyringService = "oni"
+	keyringAccount = "anilist_token"
+)
+
+// errKeyringNotFound is returned when the keyring has no token stored
+var errKeyringNotFound = errors.New("token not found in keyring")
+
+// useKeyring routes LoadToken/SaveToken to the OS keyring when enabled
+var useKeyring bool
+
+// SetKeyringEnabled selects the OS keyring (true) or the token file (false) for storing the token
+func SetKeyringEnabled(enabled bool) {
+	useKeyring = enabled
+}
+
+// keyringGet reads the token from the OS keyring
+// macOS uses the login keychain via security, other Unix systems use libsecret via secret-tool
+func keyringGet() (string, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "find-generic-password", "-s", keyringService, "-a", keyringAccount, "-w")
+	case "windows":
+		return "", fmt.Errorf("keyring storage is not supported on %s", runtime.GOOS)
+	default:
+		cmd = exec.Command("secret-tool", "lookup", "service", keyringService, "account", keyringAccount)
+	}
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			// Both tools exit non-zero when the item doesn't exist
+			return "", errKeyringNotFound
+		}
+		return "", fmt.Errorf("failed to read keyring: %w", err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", errKeyringNotFound
+	}
+	return token, nil
+}
+
+// keyringSet stores the token in the OS keyring, replacing any existing one
+func keyringSet(token string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		// security takes the password as an argument, so run it in interactive mode and send the
+		// command on stdin; that keeps the token out of the process list
+		if strings.ContainsAny(token, "\"\\\n") {
+			return fmt.Errorf("token contains characters that can't be stored in the keychain")
+		}
+		cmd = exec.Command("security", "-i")
+		cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -w \"%s\"\n", keyringService, keyringAccount, token))
+	case "windows":
+		return fmt.Errorf("keyring storage is not supported on %s", runtime.GOOS)
+	default:
+		// secret-tool reads the secret from stdin so it never shows up in the process list
+		cmd = exec.Command("secret-tool", "store", "--label=oni AniList token", "service", keyringService, "account", keyringAccount)
+		cmd.Stdin = strings.NewReader(token)
+	}
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to write keyring: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	// Interactive security exits cleanly even when a command fails, so read the token back
+	if runtime.GOOS == "darwin" {
+		if stored, err := keyringGet(); err != nil || stored != token {
+			return fmt.Errorf("failed to write keyring: %s", strings.TrimSpace(string(output)))
+		}
+	}
+	return nil
+}
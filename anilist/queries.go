@@ -5,6 +5,43 @@ const SearchAnimeQuery = `
 query ($search: String, $page: Int, $perPage: Int, $isAdult: Boolean) {
   Page(page: $page, perPage: $perPage) {
     media(search: $search, type: ANIME, isAdult: $isAdult) {
+      id
+      title {
+        userPreferred
+        romaji
+        english
+        native
+      }
+      coverImage {
+        extraLarge
+        large
+        medium
+      }
+      startDate {
+        year
+        month
+        day
+      }
+      episodes
+      duration
+      status
+      description
+      averageScore
+      isAdult
+    }
+  }
+}
+`
+
+// GraphQL query for browsing anime by season
+const SeasonalAnimeQuery = `
+query ($season: MediaSeason, $seasonYear: Int, $page: Int, $perPage: Int, $isAdult: Boolean) {
+  Page(page: $page, perPage: $perPage) {
+    pageInfo {
+      currentPage
+      hasNextPage
+    }
+    media(season: $season, seasonYear: $seasonYear, type: ANIME, isAdult: $isAdult, sort: POPULARITY_DESC) {
       id
       title {
         userPreferred
@@ -37,12 +74,17 @@ const GetAnimeListQuery = `
 query ($userId: Int, $status: MediaListStatus, $type: MediaType) {
   MediaListCollection(userId: $userId, type: $type, status: $status) {
     lists {
+      name
+      isCustomList
       entries {
         id
         mediaId
         status
         score
         progress
+        repeat
+        private
+        notes
         media {
           id
           title {
@@ -66,6 +108,7 @@ query ($userId: Int, $status: MediaListStatus, $type: MediaType) {
           description
           averageScore
           isAdult
+          isFavourite
         }
       }
     }
@@ -82,15 +125,27 @@ query {
 }
 `
 
+// GraphQL query for getting the user's score format
+const GetScoreFormatQuery = `
+query {
+  Viewer {
+    mediaListOptions {
+      scoreFormat
+    }
+  }
+}
+`
+
 // GraphQL mutation for updating progress
 const UpdateProgressMutation = `
-mutation ($mediaId: Int, $progress: Int, $status: MediaListStatus) {
-  SaveMediaListEntry(mediaId: $mediaId, progress: $progress, status: $status) {
+mutation ($mediaId: Int, $progress: Int, $status: MediaListStatus, $private: Boolean) {
+  SaveMediaListEntry(mediaId: $mediaId, progress: $progress, status: $status, private: $private) {
     id
     mediaId
     status
     score
     progress
+    private
     media {
       id
       title {
@@ -102,6 +157,19 @@ mutation ($mediaId: Int, $progress: Int, $status: MediaListStatus) {
 }
 `
 
+// GraphQL mutation for finishing a rewatch: bumps the rewatch count and keeps the entry REPEATING
+const UpdateRewatchMutation = `
+mutation ($mediaId: Int, $progress: Int, $repeat: Int, $private: Boolean) {
+  SaveMediaListEntry(mediaId: $mediaId, progress: $progress, status: REPEATING, repeat: $repeat, private: $private) {
+    id
+    mediaId
+    status
+    progress
+    repeat
+  }
+}
+`
+
 // GraphQL mutation for updating score
 const UpdateScoreMutation = `
 mutation ($mediaId: Int, $score: Float) {
@@ -121,6 +189,17 @@ mutation ($mediaId: Int, $score: Float) {
 }
 `
 
+// GraphQL mutation for updating the notes on a list entry
+const UpdateNotesMutation = `
+mutation ($mediaId: Int, $notes: String) {
+  SaveMediaListEntry(mediaId: $mediaId, notes: $notes) {
+    id
+    mediaId
+    notes
+  }
+}
+`
+
 // GraphQL mutation for updating status
 const UpdateStatusMutation = `
 mutation ($mediaId: Int, $status: MediaListStatus) {
@@ -140,6 +219,19 @@ mutation ($mediaId: Int, $status: MediaListStatus) {
 }
 `
 
+// GraphQL mutation for adding an anime to or removing it from the user's favourites
+const ToggleFavouriteMutation = `
+mutation ($animeId: Int) {
+  ToggleFavourite(animeId: $animeId) {
+    anime {
+      pageInfo {
+        total
+      }
+    }
+  }
+}
+`
+
 // GraphQL query for getting anime info
 const GetAnimeInfoQuery = `
 query ($id: Int) {
@@ -162,11 +254,50 @@ query ($id: Int) {
       day
     }
     episodes
+    duration
     status
     description
     averageScore
     isAdult
+    trailer {
+      id
+      site
+    }
+    relations {
+      edges {
+        relationType
+        node {
+          id
+          type
+          title {
+            userPreferred
+            romaji
+            english
+          }
+          coverImage {
+            large
+          }
+          startDate {
+            year
+          }
+          episodes
+          duration
+          status
+        }
+      }
+    }
   }
 }
 `
 
+// StreamingEpisodesQuery fetches the streaming site episodes of an anime, used for thumbnails
+const StreamingEpisodesQuery = `
+query ($id: Int) {
+  Media(id: $id, type: ANIME) {
+    streamingEpisodes {
+      title
+      thumbnail
+    }
+  }
+}
+`
@@ -2,15 +2,71 @@ package anilist
 
 // Anime represents an anime from AniList
 type Anime struct {
-	ID            int    `json:"id"`
-	Title         Title  `json:"title"`
-	CoverImage    Cover  `json:"coverImage"`
-	StartDate     Date   `json:"startDate"`
-	Episodes      *int   `json:"episodes"`
-	Status        string `json:"status"`
-	Description   string `json:"description"`
-	AverageScore  *int   `json:"averageScore"`
-	IsAdult       bool   `json:"isAdult"`
+	ID           int        `json:"id"`
+	Title        Title      `json:"title"`
+	CoverImage   Cover      `json:"coverImage"`
+	StartDate    Date       `json:"startDate"`
+	Episodes     *int       `json:"episodes"`
+	Duration     *int       `json:"duration"` // Minutes per episode
+	Status       string     `json:"status"`
+	Description  string     `json:"description"`
+	AverageScore *int       `json:"averageScore"`
+	IsAdult      bool       `json:"isAdult"`
+	IsFavourite  bool       `json:"isFavourite"` // Only filled in for the viewer's own lists
+	Trailer      *Trailer   `json:"trailer,omitempty"`
+	Relations    *Relations `json:"relations,omitempty"`
+}
+
+// Relations holds the media an anime is related to (sequels, prequels, ...)
+type Relations struct {
+	Edges []RelationEdge `json:"edges"`
+}
+
+// RelationEdge is one related media entry and how it relates
+type RelationEdge struct {
+	RelationType string       `json:"relationType"`
+	Node         RelatedMedia `json:"node"`
+}
+
+// RelatedMedia is a related entry, which may be an anime or a manga
+type RelatedMedia struct {
+	Anime
+	Type string `json:"type"`
+}
+
+// typicalEpisodeMinutes stands in for episode length when AniList doesn't know it
+const typicalEpisodeMinutes = 24
+
+// WatchMinutes estimates how long the whole show takes to watch, or 0 when the episode count is unknown
+func (a Anime) WatchMinutes() int {
+	if a.Episodes == nil {
+		return 0
+	}
+	duration := typicalEpisodeMinutes
+	if a.Duration != nil && *a.Duration > 0 {
+		duration = *a.Duration
+	}
+	return *a.Episodes * duration
+}
+
+// Trailer identifies an anime's trailer video on an external site
+type Trailer struct {
+	ID   string `json:"id"`
+	Site string `json:"site"`
+}
+
+// URL returns a playable link for the trailer, or "" for unsupported sites
+func (t *Trailer) URL() string {
+	if t == nil || t.ID == "" {
+		return ""
+	}
+	switch t.Site {
+	case "youtube":
+		return "https://www.youtube.com/watch?v=" + t.ID
+	case "dailymotion":
+		return "https://www.dailymotion.com/video/" + t.ID
+	}
+	return ""
 }
 
 // Title represents anime titles
@@ -21,6 +77,40 @@ type Title struct {
 	Native        string `json:"native"`
 }
 
+// Field returns one script of the title: "romaji", "english" or "native"
+func (t Title) Field(name string) string {
+	switch name {
+	case "romaji":
+		return t.Romaji
+	case "english":
+		return t.English
+	case "native":
+		return t.Native
+	}
+	return t.UserPreferred
+}
+
+// Names returns the distinct non-empty titles, preferred title first
+func (t Title) Names() []string {
+	var names []string
+	for _, name := range []string{t.UserPreferred, t.Romaji, t.English} {
+		if name == "" {
+			continue
+		}
+		duplicate := false
+		for _, existing := range names {
+			if existing == name {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // Cover represents cover image URLs
 type Cover struct {
 	ExtraLarge string `json:"extraLarge"`
@@ -28,6 +118,12 @@ type Cover struct {
 	Medium     string `json:"medium"`
 }
 
+// StreamingEpisode is an episode on a licensed streaming site, e.g. "Episode 3 - Title" with its thumbnail
+type StreamingEpisode struct {
+	Title     string `json:"title"`
+	Thumbnail string `json:"thumbnail"`
+}
+
 // Date represents a date
 type Date struct {
 	Year  *int `json:"year"`
@@ -42,12 +138,17 @@ type MediaListEntry struct {
 	Status    string `json:"status"`
 	Score     *float64 `json:"score"`
 	Progress  int    `json:"progress"`
+	Repeat    int    `json:"repeat"` // Times the show has been rewatched
+	Private   bool   `json:"private"` // Hidden from the user's public list
+	Notes     string `json:"notes"`
 	Media     Anime  `json:"media"`
 }
 
 // MediaList represents a collection of list entries
 type MediaList struct {
-	Entries []MediaListEntry `json:"entries"`
+	Name         string           `json:"name"`
+	IsCustomList bool             `json:"isCustomList"`
+	Entries      []MediaListEntry `json:"entries"`
 }
 
 // MediaListCollection represents the user's complete list
@@ -62,6 +163,20 @@ type SearchResponse struct {
 	} `json:"Page"`
 }
 
+// PageInfo represents pagination info for a Page query
+type PageInfo struct {
+	CurrentPage int  `json:"currentPage"`
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// SeasonalResponse represents seasonal browse results
+type SeasonalResponse struct {
+	Page struct {
+		PageInfo PageInfo `json:"pageInfo"`
+		Media    []Anime  `json:"media"`
+	} `json:"Page"`
+}
+
 // ListResponse represents list query results
 type ListResponse struct {
 	MediaListCollection MediaListCollection `json:"MediaListCollection"`
@@ -74,6 +189,15 @@ type UserResponse struct {
 	} `json:"Viewer"`
 }
 
+// ScoreFormatResponse represents the user's list score format
+type ScoreFormatResponse struct {
+	Viewer struct {
+		MediaListOptions struct {
+			ScoreFormat string `json:"scoreFormat"`
+		} `json:"mediaListOptions"`
+	} `json:"Viewer"`
+}
+
 // UpdateResponse represents mutation response
 type UpdateResponse struct {
 	SaveMediaListEntry MediaListEntry `json:"SaveMediaListEntry"`
@@ -1,31 +1,35 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 
 	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/utils"
 	"gopkg.in/ini.v1"
 )
 
 // GetConfigPath returns the path to the configuration file
 func GetConfigPath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	configDir, err := utils.ConfigDir()
 	if err != nil {
-		logger.Error("Failed to get home directory", err, nil)
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		logger.Error("Failed to resolve config directory", err, nil)
+		return "", fmt.Errorf("failed to resolve config directory: %w", err)
 	}
 
-	configDir := filepath.Join(homeDir, ".oni")
-	if err := os.MkdirAll(configDir, 0755); err != nil {
-		logger.Error("Failed to create config directory", err, map[string]interface{}{
-			"path": configDir,
+	configPath := filepath.Join(configDir, "config.ini")
+	if err := utils.MigrateLegacyFile(configPath, "config.ini"); err != nil {
+		logger.Warn("Failed to migrate config file", map[string]interface{}{
+			"path":  configPath,
+			"error": err.Error(),
 		})
-		return "", fmt.Errorf("failed to create config directory: %w", err)
 	}
-
-	configPath := filepath.Join(configDir, "config.ini")
 	logger.Debug("Config path resolved", map[string]interface{}{
 		"path": configPath,
 	})
@@ -35,18 +39,10 @@ func GetConfigPath() (string, error) {
 
 // GetDataDir returns the path to the data directory
 func GetDataDir() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	dataDir, err := utils.DataDir()
 	if err != nil {
-		logger.Error("Failed to get home directory", err, nil)
-		return "", fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	dataDir := filepath.Join(homeDir, ".oni")
-	if err := os.MkdirAll(dataDir, 0755); err != nil {
-		logger.Error("Failed to create data directory", err, map[string]interface{}{
-			"path": dataDir,
-		})
-		return "", fmt.Errorf("failed to create data directory: %w", err)
+		logger.Error("Failed to resolve data directory", err, nil)
+		return "", fmt.Errorf("failed to resolve data directory: %w", err)
 	}
 
 	logger.Debug("Data directory resolved", map[string]interface{}{
@@ -56,20 +52,28 @@ func GetDataDir() (string, error) {
 	return dataDir, nil
 }
 
-// Load reads the configuration from the INI file
-func Load() (*Config, error) {
-	logger.Debug("Loading configuration", nil)
-
-	configPath, err := GetConfigPath()
-	if err != nil {
-		return nil, err
+// defaultPlayer picks the player a fresh install is most likely to have
+// IINA on macOS when it is installed, mpv.exe on Windows and mpv everywhere else
+func defaultPlayer() string {
+	switch runtime.GOOS {
+	case "darwin":
+		if _, err := exec.LookPath("iina"); err == nil {
+			return "iina"
+		}
+	case "windows":
+		return "mpv.exe"
 	}
+	return "mpv"
+}
 
-	// Create default config
-	cfg := &Config{
+// Default returns the default configuration
+func Default() *Config {
+	return &Config{
 		Player: PlayerConfig{
-			Player:          "mpv",
-			PlayerArguments: "",
+			Player:           defaultPlayer(),
+			PlayerArguments:  "",
+			SubtitleFontSize: 0,
+			SubtitleColor:    "",
 		},
 		Provider: ProviderConfig{
 			Provider:    "allanime",
@@ -79,24 +83,53 @@ func Load() (*Config, error) {
 		AniList: AniListConfig{
 			NoAniList:         false,
 			ScoreOnCompletion: false,
+			TokenStorage:      "file",
+			PrivateIncognitoUpdates: false,
+			AutoAddOnPlay:           true,
+			CacheRefreshMinutes:     5,
 		},
 		UI: UIConfig{
 			UseExternalMenu: false,
 			ImagePreview:    false,
 			JSONOutput:      false,
+			RestoreSession:  false,
+			LaunchAction:    "menu",
+			MenuItems:       strings.Join(MenuItemNames, ", "),
 		},
 		Playback: PlaybackConfig{
 			SubOrDub:              "sub",
 			SubsLanguage:          "english",
 			PersistIncognitoSessions: false,
+			CompletionThreshold:   85,
+			NextEpisodeThreshold:  95,
+			ResumeAutoplay:        false,
 		},
 		Discord: DiscordConfig{
 			DiscordPresence: false,
+			AppID:           "",
+			DetailsTemplate: "Watching {title}",
+			StateTemplate:   "Episode {episode}",
+			SmallImage:      "",
+			SmallText:       "",
 		},
 		Advanced: AdvancedConfig{
 			ShowAdultContent: false,
+			LogLevel:         "info",
 		},
 	}
+}
+
+// Load reads the configuration from the INI file
+func Load() (*Config, error) {
+	logger.Debug("Loading configuration", nil)
+
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create default config
+	cfg := Default()
 
 	// Check if config file exists
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
@@ -135,9 +168,11 @@ func Load() (*Config, error) {
 		})
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	cfg.Provider.Qualities = loadProviderOverrides(iniFile, "quality")
+	cfg.Provider.Proxies = loadProviderOverrides(iniFile, "proxy")
 
 	// Validate configuration
-	if err := cfg.Validate(); err != nil {
+	if err := errors.Join(cfg.validateValues()...); err != nil {
 		logger.Error("Configuration validation failed", err, map[string]interface{}{
 			"path": configPath,
 		})
@@ -171,6 +206,9 @@ func Save(cfg *Config) error {
 		return fmt.Errorf("failed to reflect config: %w", err)
 	}
 
+	saveProviderOverrides(iniFile, "quality", cfg.Provider.Qualities)
+	saveProviderOverrides(iniFile, "proxy", cfg.Provider.Proxies)
+
 	if err := iniFile.SaveTo(configPath); err != nil {
 		logger.Error("Failed to save config file", err, map[string]interface{}{
 			"path": configPath,
@@ -185,3 +223,45 @@ func Save(cfg *Config) error {
 	return nil
 }
 
+// providerSection returns the INI section name holding overrides for a provider
+func providerSection(provider string) string {
+	return "provider." + provider
+}
+
+// loadProviderOverrides reads a setting such as quality or proxy from [provider.<name>] sections, by provider
+// Returns nil when no provider sets it
+func loadProviderOverrides(iniFile *ini.File, name string) map[string]string {
+	var overrides map[string]string
+	for _, provider := range validProviders {
+		section, err := iniFile.GetSection(providerSection(provider))
+		if err != nil {
+			continue
+		}
+		// Child sections inherit parent keys, so only use a value set on the section itself
+		if !section.HasKey(name) {
+			continue
+		}
+		if overrides == nil {
+			overrides = make(map[string]string)
+		}
+		overrides[provider] = section.Key(name).String()
+	}
+	return overrides
+}
+
+// saveProviderOverrides writes a per-provider setting such as quality or proxy to [provider.<name>] sections
+func saveProviderOverrides(iniFile *ini.File, name string, overrides map[string]string) {
+	providers := make([]string, 0, len(overrides))
+	for provider := range overrides {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+
+	for _, provider := range providers {
+		value := overrides[provider]
+		if value == "" {
+			continue
+		}
+		iniFile.Section(providerSection(provider)).Key(name).SetValue(value)
+	}
+}
@@ -1,7 +1,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"os/exec"
+	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -18,8 +23,10 @@ type Config struct {
 
 // PlayerConfig contains player-related settings
 type PlayerConfig struct {
-	Player          string `ini:"player"`
-	PlayerArguments string `ini:"player_arguments"`
+	Player           string `ini:"player"`
+	PlayerArguments  string `ini:"player_arguments"`
+	SubtitleFontSize int    `ini:"subtitle_font_size"` // mpv --sub-font-size; 0 keeps the player's default
+	SubtitleColor    string `ini:"subtitle_color"`     // mpv --sub-color, e.g. #FFFF00; empty keeps the player's default
 }
 
 // ProviderConfig contains provider-related settings
@@ -27,12 +34,23 @@ type ProviderConfig struct {
 	Provider     string `ini:"provider"`
 	DownloadDir  string `ini:"download_dir"`
 	Quality      string `ini:"quality"`
+	Qualities    map[string]string `ini:"-"` // Per-provider overrides from [provider.<name>] sections
+	// Quality from -q for this session only; wins over both quality settings and is never saved
+	SessionQuality string `ini:"-"`
+	// Per-provider proxy URLs from [provider.<name>] sections; other providers use HTTP_PROXY/HTTPS_PROXY
+	Proxies map[string]string `ini:"-"`
 }
 
 // AniListConfig contains AniList integration settings
 type AniListConfig struct {
 	NoAniList          bool `ini:"no_anilist"`
 	ScoreOnCompletion  bool `ini:"score_on_completion"`
+	TokenStorage       string `ini:"token_storage"` // "file" or "keyring"
+	PrivateIncognitoUpdates bool `ini:"private_incognito_updates"` // Update AniList in incognito, marking the entries private
+	// Add a show that isn't on the user's lists to Watching when an episode of it is finished; otherwise leave AniList alone
+	AutoAddOnPlay bool `ini:"auto_add_on_play"`
+	// Minutes the cached lists count as fresh before they are refreshed; 0 refreshes every time
+	CacheRefreshMinutes int `ini:"cache_refresh_minutes"`
 }
 
 // UIConfig contains UI-related settings
@@ -40,6 +58,10 @@ type UIConfig struct {
 	UseExternalMenu bool `ini:"use_external_menu"`
 	ImagePreview    bool `ini:"image_preview"`
 	JSONOutput      bool `ini:"json_output"`
+	RestoreSession  bool `ini:"restore_session"` // Reopen the last screen (and list position) at startup
+	LaunchAction    string `ini:"launch_action"` // "menu", or "continue" to resume the last watched show at startup
+	// Main menu entries in order, from MenuItemNames; entries left out are hidden
+	MenuItems string `ini:"menu_items"`
 }
 
 // PlaybackConfig contains playback-related settings
@@ -47,49 +69,252 @@ type PlaybackConfig struct {
 	SubOrDub              string `ini:"sub_or_dub"`
 	SubsLanguage          string `ini:"subs_language"`
 	PersistIncognitoSessions bool `ini:"persist_incognito_sessions"`
+	CompletionThreshold   int    `ini:"completion_threshold"`   // Percent watched for an episode to count as complete
+	NextEpisodeThreshold  int    `ini:"next_episode_threshold"` // Percent watched before continue watching offers the next episode
+	ResumeAutoplay        bool   `ini:"resume_autoplay"`        // Offer to pick an interrupted autoplay run back up at startup
 }
 
 // DiscordConfig contains Discord presence settings
 type DiscordConfig struct {
-	DiscordPresence bool `ini:"discord_presence"`
+	DiscordPresence bool   `ini:"discord_presence"`
+	AppID           string `ini:"app_id"`
+	DetailsTemplate string `ini:"details_template"`
+	StateTemplate   string `ini:"state_template"`
+	SmallImage      string `ini:"small_image"`
+	SmallText       string `ini:"small_text"`
 }
 
 // AdvancedConfig contains advanced settings
 type AdvancedConfig struct {
-	ShowAdultContent bool `ini:"show_adult_content"`
+	ShowAdultContent bool   `ini:"show_adult_content"`
+	LogLevel         string `ini:"log_level"`
+	// Base directory for history, caches and the AniList token; empty keeps the XDG locations
+	DataDir string `ini:"data_dir"`
 }
 
-// Validate validates all configuration values
+// validProviders lists the providers that can be configured
+var validProviders = []string{"allanime", "aniwatch", "yugen", "hdrezka", "aniworld", "gogoanime", "animepahe"}
+
+// Providers returns the names of the providers that can be configured
+func Providers() []string {
+	return append([]string(nil), validProviders...)
+}
+
+// IsProvider reports whether name is a provider that can be configured
+func IsProvider(name string) bool {
+	return contains(validProviders, name)
+}
+
+// NextProvider returns the provider after current, wrapping around to the first
+func NextProvider(current string) string {
+	for i, provider := range validProviders {
+		if provider == current {
+			return validProviders[(i+1)%len(validProviders)]
+		}
+	}
+	return validProviders[0]
+}
+
+// validPlayers lists the supported players
+var validPlayers = []string{"mpv", "mpv.exe", "vlc", "iina", "remote-mpv", "print"}
+
+// remotePlayers are valid players that don't run locally, so there's nothing to find on PATH
+var remotePlayers = []string{"remote-mpv", "print"}
+
+// fallbackPlayers are the local players tried, in order, when the configured one isn't installed
+var fallbackPlayers = []string{"mpv", "iina", "vlc"}
+
+// DetectPlayer returns the configured player, or the first installed fallback when it isn't on PATH
+// Reports false when no player is installed at all
+func DetectPlayer(name string) (string, bool) {
+	if contains(remotePlayers, name) {
+		return name, true
+	}
+	if _, err := exec.LookPath(name); err == nil {
+		return name, true
+	}
+	for _, candidate := range fallbackPlayers {
+		if _, err := exec.LookPath(candidate); err == nil {
+			return candidate, true
+		}
+	}
+	return name, false
+}
+
+// validProxySchemes lists the proxy URL schemes Go's HTTP client can use
+var validProxySchemes = []string{"http", "https", "socks5", "socks5h"}
+
+// validQualities lists the accepted quality values
+var validQualities = []string{"1080", "720", "480", "360", "240", "best", "worst", "ask"}
+
+// QualityFor returns the quality to use for a provider, preferring -q, then its override, then the global quality
+func (c *Config) QualityFor(provider string) string {
+	if c.Provider.SessionQuality != "" {
+		return c.Provider.SessionQuality
+	}
+	if quality, ok := c.Provider.Qualities[provider]; ok && quality != "" {
+		return quality
+	}
+	return c.Provider.Quality
+}
+
+// MenuItemNames are the main menu entries menu_items can list, in their default order
+var MenuItemNames = []string{"continue", "recent", "watch", "season", "update", "settings", "quit"}
+
+// MenuItems returns the main menu entries listed in menu_items; an empty setting lists them all
+func (c *Config) MenuItems() []string {
+	var items []string
+	for _, item := range strings.Split(c.UI.MenuItems, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		// A copy, so callers can't change the defaults
+		return append([]string(nil), MenuItemNames...)
+	}
+	return items
+}
+
+// Validate validates all configuration values and checks a player is installed
+// All problems are reported together rather than stopping at the first one
 func (c *Config) Validate() error {
+	errs := c.validateValues()
+
+	// Only check PATH for a known player so a typo isn't reported twice
+	// An installed fallback is used in its place, so only report when there is none
+	if contains(validPlayers, c.Player.Player) {
+		if _, found := DetectPlayer(c.Player.Player); !found {
+			errs = append(errs, fmt.Errorf("player '%s' was not found on your PATH", c.Player.Player))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateValues checks each configuration value against its accepted values
+// Used on load, where a missing player binary shouldn't stop the app from starting
+func (c *Config) validateValues() []error {
+	var errs []error
+
 	// Validate player
-	validPlayers := []string{"mpv", "vlc", "iina"}
 	if !contains(validPlayers, c.Player.Player) {
-		return fmt.Errorf("invalid player '%s': must be one of [%s]",
-			c.Player.Player, strings.Join(validPlayers, ", "))
+		errs = append(errs, fmt.Errorf("invalid player '%s': must be one of [%s]",
+			c.Player.Player, strings.Join(validPlayers, ", ")))
+	}
+
+	// remote-mpv has to know where to send the stream
+	if c.Player.Player == "remote-mpv" && !strings.Contains(c.Player.PlayerArguments, "socket=") {
+		errs = append(errs, fmt.Errorf("player 'remote-mpv' needs player_arguments = socket=host:port"))
 	}
 
 	// Validate provider
-	validProviders := []string{"allanime", "aniwatch", "yugen", "hdrezka", "aniworld"}
 	if !contains(validProviders, c.Provider.Provider) {
-		return fmt.Errorf("invalid provider '%s': must be one of [%s]",
-			c.Provider.Provider, strings.Join(validProviders, ", "))
+		errs = append(errs, fmt.Errorf("invalid provider '%s': must be one of [%s]",
+			c.Provider.Provider, strings.Join(validProviders, ", ")))
 	}
 
 	// Validate quality
-	validQualities := []string{"1080", "720", "480", "360"}
 	if !contains(validQualities, c.Provider.Quality) {
-		return fmt.Errorf("invalid quality '%s': must be one of [%s]",
-			c.Provider.Quality, strings.Join(validQualities, ", "))
+		errs = append(errs, fmt.Errorf("invalid quality '%s': must be one of [%s]",
+			c.Provider.Quality, strings.Join(validQualities, ", ")))
+	}
+	providers := make([]string, 0, len(c.Provider.Qualities))
+	for provider := range c.Provider.Qualities {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	for _, provider := range providers {
+		quality := c.Provider.Qualities[provider]
+		if !contains(validQualities, quality) {
+			errs = append(errs, fmt.Errorf("invalid quality '%s' for provider '%s': must be one of [%s]",
+				quality, provider, strings.Join(validQualities, ", ")))
+		}
+	}
+
+	// Validate per-provider proxies
+	proxied := make([]string, 0, len(c.Provider.Proxies))
+	for provider := range c.Provider.Proxies {
+		proxied = append(proxied, provider)
+	}
+	sort.Strings(proxied)
+	for _, provider := range proxied {
+		proxy := c.Provider.Proxies[provider]
+		proxyURL, err := url.Parse(proxy)
+		if err != nil || !contains(validProxySchemes, proxyURL.Scheme) || proxyURL.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid proxy '%s' for provider '%s': must be a URL like socks5://host:port (schemes: %s)",
+				proxy, provider, strings.Join(validProxySchemes, ", ")))
+		}
+	}
+
+	// Validate subtitle_font_size
+	if c.Player.SubtitleFontSize < 0 {
+		errs = append(errs, fmt.Errorf("invalid subtitle_font_size '%d': must be 0 (player default) or more",
+			c.Player.SubtitleFontSize))
 	}
 
 	// Validate sub_or_dub
 	validSubOrDub := []string{"sub", "dub"}
 	if !contains(validSubOrDub, c.Playback.SubOrDub) {
-		return fmt.Errorf("invalid sub_or_dub '%s': must be one of [%s]",
-			c.Playback.SubOrDub, strings.Join(validSubOrDub, ", "))
+		errs = append(errs, fmt.Errorf("invalid sub_or_dub '%s': must be one of [%s]",
+			c.Playback.SubOrDub, strings.Join(validSubOrDub, ", ")))
+	}
+
+	// Validate watch thresholds
+	if c.Playback.CompletionThreshold < 1 || c.Playback.CompletionThreshold > 100 {
+		errs = append(errs, fmt.Errorf("invalid completion_threshold '%d': must be between 1 and 100",
+			c.Playback.CompletionThreshold))
+	}
+	if c.Playback.NextEpisodeThreshold < 1 || c.Playback.NextEpisodeThreshold > 100 {
+		errs = append(errs, fmt.Errorf("invalid next_episode_threshold '%d': must be between 1 and 100",
+			c.Playback.NextEpisodeThreshold))
+	}
+
+	// Validate token_storage
+	validTokenStorage := []string{"file", "keyring"}
+	if !contains(validTokenStorage, c.AniList.TokenStorage) {
+		errs = append(errs, fmt.Errorf("invalid token_storage '%s': must be one of [%s]",
+			c.AniList.TokenStorage, strings.Join(validTokenStorage, ", ")))
+	}
+
+	// Validate cache_refresh_minutes
+	if c.AniList.CacheRefreshMinutes < 0 {
+		errs = append(errs, fmt.Errorf("invalid cache_refresh_minutes '%d': must be 0 (always refresh) or more",
+			c.AniList.CacheRefreshMinutes))
+	}
+
+	// Validate launch_action
+	validLaunchActions := []string{"menu", "continue"}
+	if !contains(validLaunchActions, c.UI.LaunchAction) {
+		errs = append(errs, fmt.Errorf("invalid launch_action '%s': must be one of [%s]",
+			c.UI.LaunchAction, strings.Join(validLaunchActions, ", ")))
+	}
+
+	// Validate menu_items
+	seenMenuItems := make(map[string]bool)
+	for _, item := range c.MenuItems() {
+		if !contains(MenuItemNames, item) {
+			errs = append(errs, fmt.Errorf("invalid menu_items entry '%s': must be one of [%s]",
+				item, strings.Join(MenuItemNames, ", ")))
+		} else if seenMenuItems[item] {
+			errs = append(errs, fmt.Errorf("invalid menu_items: '%s' is listed twice", item))
+		}
+		seenMenuItems[item] = true
+	}
+
+	// Validate log_level
+	validLogLevels := []string{"debug", "info", "warn", "error"}
+	if !contains(validLogLevels, c.Advanced.LogLevel) {
+		errs = append(errs, fmt.Errorf("invalid log_level '%s': must be one of [%s]",
+			c.Advanced.LogLevel, strings.Join(validLogLevels, ", ")))
+	}
+
+	// Validate data_dir
+	if c.Advanced.DataDir != "" && !filepath.IsAbs(c.Advanced.DataDir) {
+		errs = append(errs, fmt.Errorf("invalid data_dir '%s': must be an absolute path", c.Advanced.DataDir))
 	}
 
-	return nil
+	return errs
 }
 
 // contains checks if a string slice contains a specific string
@@ -3,36 +3,73 @@ package discord
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hugolgst/rich-go/client"
+	"github.com/pranshuj73/oni/config"
 	"github.com/pranshuj73/oni/logger"
 )
 
-const defaultDiscordAppID = "1436820992306450532"
+const (
+	defaultDiscordAppID    = "1436820992306450532"
+	defaultDetailsTemplate = "Watching {title}"
+	defaultStateTemplate   = "Episode {episode}"
+)
 
-// getDiscordAppID returns the Discord app ID from environment variable or default
-func getDiscordAppID() string {
+// getDiscordAppID returns the Discord app ID from environment variable, config or default
+func getDiscordAppID(configured string) string {
 	if appID := os.Getenv("ONI_DISCORD_APP_ID"); appID != "" {
 		logger.Debug("Using custom Discord app ID from environment", map[string]interface{}{
 			"source": "ONI_DISCORD_APP_ID",
 		})
 		return appID
 	}
+	if configured != "" {
+		logger.Debug("Using custom Discord app ID from config", map[string]interface{}{
+			"source": "config",
+		})
+		return configured
+	}
 	return defaultDiscordAppID
 }
 
+// renderTemplate replaces {title}, {episode} and {year} placeholders in a template
+func renderTemplate(template string, title string, episode int, year int) string {
+	yearStr := ""
+	if year > 0 {
+		yearStr = strconv.Itoa(year)
+	}
+
+	replacer := strings.NewReplacer(
+		"{title}", title,
+		"{episode}", strconv.Itoa(episode),
+		"{year}", yearStr,
+	)
+	return strings.TrimSpace(replacer.Replace(template))
+}
+
 // PresenceManager manages Discord Rich Presence
 type PresenceManager struct {
-	enabled    bool
-	connected  bool
+	enabled   bool
+	connected bool
+	cfg       config.DiscordConfig
 }
 
 // NewPresenceManager creates a new presence manager
-func NewPresenceManager(enabled bool) *PresenceManager {
+func NewPresenceManager(cfg config.DiscordConfig) *PresenceManager {
+	if cfg.DetailsTemplate == "" {
+		cfg.DetailsTemplate = defaultDetailsTemplate
+	}
+	if cfg.StateTemplate == "" {
+		cfg.StateTemplate = defaultStateTemplate
+	}
+
 	return &PresenceManager{
-		enabled:   enabled,
+		enabled:   cfg.DiscordPresence,
 		connected: false,
+		cfg:       cfg,
 	}
 }
 
@@ -48,7 +85,7 @@ func (pm *PresenceManager) Connect() error {
 		return nil
 	}
 
-	appID := getDiscordAppID()
+	appID := getDiscordAppID(pm.cfg.AppID)
 	logger.Debug("Attempting to connect to Discord", map[string]interface{}{
 		"appID": appID,
 	})
@@ -68,15 +105,19 @@ func (pm *PresenceManager) Connect() error {
 }
 
 // SetPresence sets the Discord Rich Presence
-func (pm *PresenceManager) SetPresence(title string, episode int, year int, coverURL string) error {
+// When duration is known, an end timestamp is set so Discord shows a progress bar
+func (pm *PresenceManager) SetPresence(mediaID int, title string, episode int, year int, coverURL string, duration time.Duration, position time.Duration) error {
 	if !pm.enabled {
 		return nil
 	}
 
 	logger.Debug("Setting Discord presence", map[string]interface{}{
-		"title":   title,
-		"episode": episode,
-		"year":    year,
+		"mediaID":  mediaID,
+		"title":    title,
+		"episode":  episode,
+		"year":     year,
+		"duration": duration.String(),
+		"position": position.String(),
 	})
 
 	// Ensure we're connected
@@ -86,15 +127,33 @@ func (pm *PresenceManager) SetPresence(title string, episode int, year int, cove
 		}
 	}
 
-	now := time.Now()
+	// Offset the start by the resume position so elapsed time matches the player
+	start := time.Now().Add(-position)
+	timestamps := &client.Timestamps{
+		Start: &start,
+	}
+	if duration > 0 && position < duration {
+		end := start.Add(duration)
+		timestamps.End = &end
+	}
+
 	activity := client.Activity{
-		Details:    fmt.Sprintf("Watching %s", title),
-		State:      fmt.Sprintf("Episode %d", episode),
+		Details:    renderTemplate(pm.cfg.DetailsTemplate, title, episode, year),
+		State:      renderTemplate(pm.cfg.StateTemplate, title, episode, year),
 		LargeImage: coverURL,
 		LargeText:  title,
-		Timestamps: &client.Timestamps{
-			Start: &now,
-		},
+		SmallImage: pm.cfg.SmallImage,
+		SmallText:  renderTemplate(pm.cfg.SmallText, title, episode, year),
+		Timestamps: timestamps,
+	}
+
+	if mediaID > 0 {
+		activity.Buttons = []*client.Button{
+			{
+				Label: "View on AniList",
+				Url:   fmt.Sprintf("https://anilist.co/anime/%d", mediaID),
+			},
+		}
 	}
 
 	err := client.SetActivity(activity)
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/player"
+	"github.com/pranshuj73/oni/providers"
+)
+
+// doctorTimeout bounds each provider check, so one hanging site doesn't stall the report
+const doctorTimeout = 45 * time.Second
+
+// doctorAnime is the show each provider is asked to resolve: popular enough that every provider carries episode 1
+var doctorAnime = struct {
+	ID    int
+	Title anilist.Title
+}{
+	ID: 16498,
+	Title: anilist.Title{
+		UserPreferred: "Shingeki no Kyojin",
+		Romaji:        "Shingeki no Kyojin",
+		English:       "Attack on Titan",
+	},
+}
+
+// runDoctor checks the config, player, AniList login and every provider, and prints a report to paste into bug reports
+// Returns the exit code: 0 when everything passed, 1 otherwise
+func runDoctor(cfg *config.Config) int {
+	logger.Info("Running doctor", nil)
+	fmt.Printf("oni %s (%s/%s)\n\n", version, runtime.GOOS, runtime.GOARCH)
+
+	failed := false
+	report := func(name string, err error) {
+		if err != nil {
+			failed = true
+			fmt.Printf("FAIL  %-22s %v\n", name, err)
+			return
+		}
+		fmt.Printf("OK    %s\n", name)
+	}
+
+	report("config", cfg.Validate())
+
+	_, err := player.GetPlayer(cfg)
+	if name, _ := config.DetectPlayer(cfg.Player.Player); err == nil && name != cfg.Player.Player {
+		fmt.Printf("WARN  %-22s %s\n", "player "+cfg.Player.Player, "not installed, "+name+" is used instead")
+	} else {
+		report("player "+cfg.Player.Player, err)
+	}
+
+	if cfg.AniList.NoAniList {
+		fmt.Printf("SKIP  %-22s %s\n", "anilist token", "no_anilist is on")
+	} else {
+		anilist.SetKeyringEnabled(cfg.AniList.TokenStorage == "keyring")
+		report("anilist token", checkAniListToken())
+	}
+
+	for _, name := range config.Providers() {
+		report("provider "+name, checkProvider(cfg, name))
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// checkAniListToken verifies the saved token is accepted by AniList
+func checkAniListToken() error {
+	token, err := anilist.LoadToken()
+	if err != nil {
+		return fmt.Errorf("failed to load token: %w", err)
+	}
+	if token == "" {
+		return fmt.Errorf("not logged in; run oni to authenticate")
+	}
+	if _, err := anilist.NewClientWithToken(token); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkProvider resolves episode 1 of doctorAnime on a provider, down to a video link
+func checkProvider(cfg *config.Config, name string) error {
+	prov, err := providers.GetProvider(name)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+	defer cancel()
+
+	epInfo, err := prov.GetEpisodeInfo(ctx, doctorAnime.ID, "1", doctorAnime.Title.Names())
+	if err != nil {
+		return fmt.Errorf("failed to get episode info: %w", err)
+	}
+	if _, err := prov.GetVideoLink(ctx, epInfo, cfg.QualityFor(name), "sub"); err != nil {
+		return fmt.Errorf("failed to get video link: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/player"
+	"github.com/pranshuj73/oni/providers"
+)
+
+// Exit codes of headless playback, so scripts can tell failures apart
+const (
+	exitFailed             = 1
+	exitNotFound           = 2
+	exitEpisodeUnavailable = 3
+)
+
+// headlessError is a headless playback failure together with its exit code
+type headlessError struct {
+	code int
+	err  error
+}
+
+func (e *headlessError) Error() string { return e.err.Error() }
+func (e *headlessError) Unwrap() error { return e.err }
+
+// parseArgs parses the flags and returns the search query made of the other arguments
+// Flags may come after the query, as in `oni "One Piece" --ep 1071 -w allanime`
+func parseArgs() string {
+	var query []string
+	args := os.Args[1:]
+	for {
+		flag.CommandLine.Parse(args)
+		args = flag.Args()
+		if len(args) == 0 {
+			break
+		}
+		query = append(query, args[0])
+		args = args[1:]
+	}
+	// Unquoted words are one query, so `oni one piece` works too
+	return strings.Join(query, " ")
+}
+
+// runHeadless looks up the anime, plays one episode without the menu and returns the exit code
+func runHeadless(cfg *config.Config, client *anilist.Client, query string, episode int) int {
+	if err := playHeadless(cfg, client, query, episode); err != nil {
+		code := exitFailed
+		var headlessErr *headlessError
+		if errors.As(err, &headlessErr) {
+			code = headlessErr.code
+		}
+		logger.Error("Headless playback failed", err, map[string]interface{}{
+			"query":   query,
+			"episode": episode,
+			"code":    code,
+		})
+		fmt.Fprintf(os.Stderr, "oni: %v\n", err)
+		return code
+	}
+	return 0
+}
+
+// providerError wraps a provider failure, with exitEpisodeUnavailable only when the provider doesn't have
+// the show or episode; network, timeout and scraping failures exit with exitFailed so scripts can retry them
+func providerError(err error, episode int, title, provider string) error {
+	if errors.Is(err, providers.ErrEpisodeNotFound) || errors.Is(err, providers.ErrShowNotFound) || errors.Is(err, providers.ErrNoMapping) {
+		return &headlessError{exitEpisodeUnavailable, fmt.Errorf("episode %d of %s unavailable on %s: %w", episode, title, provider, err)}
+	}
+	return &headlessError{exitFailed, fmt.Errorf("failed to get episode %d of %s from %s: %w", episode, title, provider, err)}
+}
+
+// playHeadless plays an episode of the first AniList match for the query and records it like the TUI does
+func playHeadless(cfg *config.Config, client *anilist.Client, query string, episode int) error {
+	logger.Info("Starting headless playback", map[string]interface{}{
+		"query":    query,
+		"episode":  episode,
+		"provider": cfg.Provider.Provider,
+	})
+
+	// Looking a show up needs no account, so this also works logged out or with no_anilist
+	searchClient := client
+	if searchClient == nil {
+		searchClient = anilist.NewPublicClient()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	results, err := searchClient.SearchAnime(ctx, query, cfg.Advanced.ShowAdultContent)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to search AniList: %w", err)
+	}
+	if len(results) == 0 {
+		return &headlessError{exitNotFound, fmt.Errorf("anime not found: %q", query)}
+	}
+	anime := results[0]
+	title := anime.Title.UserPreferred
+
+	if anime.Episodes != nil && episode > *anime.Episodes {
+		return &headlessError{exitEpisodeUnavailable, fmt.Errorf("episode %d unavailable: %s has %d episodes", episode, title, *anime.Episodes)}
+	}
+
+	prov, err := providers.GetProvider(cfg.Provider.Provider)
+	if err != nil {
+		return err
+	}
+
+	providerEp := strconv.Itoa(episode)
+	if offset := providers.LoadEpisodeOffset(cfg.Provider.Provider, anime.ID); offset != 0 {
+		providerEp = providers.OffsetEpisode(providerEp, offset)
+	}
+
+	epInfo, err := prov.GetEpisodeInfo(context.Background(), anime.ID, providerEp, anime.Title.Names())
+	if err != nil {
+		return providerError(err, episode, title, cfg.Provider.Provider)
+	}
+
+	// Without a menu there is nothing to ask, so the provider's pick of quality and voiceover is used
+	videoData, err := prov.GetVideoLink(context.Background(), epInfo, cfg.QualityFor(cfg.Provider.Provider), cfg.Playback.SubOrDub)
+	if err != nil {
+		return providerError(err, episode, title, cfg.Provider.Provider)
+	}
+
+	plyr, err := player.GetPlayer(cfg)
+	if err != nil {
+		return err
+	}
+	if name, _ := config.DetectPlayer(cfg.Player.Player); name != cfg.Player.Player {
+		fmt.Fprintf(os.Stderr, "%s isn't installed, playing in %s\n", cfg.Player.Player, name)
+	}
+
+	fmt.Fprintf(os.Stderr, "Playing %s - Episode %d\n", title, episode)
+	info, err := plyr.Play(context.Background(), videoData, fmt.Sprintf("%s - Episode %d", title, episode), "00:00:00")
+	if err != nil {
+		return fmt.Errorf("failed to play video: %w", err)
+	}
+
+	episodesTotal := 0
+	if anime.Episodes != nil {
+		episodesTotal = *anime.Episodes
+	}
+	entry := player.HistoryEntry{
+		MediaID:       anime.ID,
+		Progress:      episode,
+		EpisodesTotal: episodesTotal,
+		Timestamp:     info.StoppedAt,
+		Duration:      info.TotalDuration,
+		LastWatched:   time.Now().Format(time.RFC3339),
+		Title:         title,
+		Provider:      cfg.Provider.Provider,
+		SubOrDub:      cfg.Playback.SubOrDub,
+	}
+	if err := player.SaveHistoryEntry(entry); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to save history after playback: %v\n", err)
+	}
+
+	if info.CompletedSuccessful && client != nil && !cfg.AniList.NoAniList {
+		status := "CURRENT"
+		if anime.Episodes != nil && episode >= *anime.Episodes {
+			status = "COMPLETED"
+		}
+		if err := client.UpdateProgress(context.Background(), anime.ID, episode, status); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to update AniList progress: %v\n", err)
+		}
+	}
+
+	logger.Info("Headless playback finished", map[string]interface{}{
+		"mediaID":   anime.ID,
+		"episode":   episode,
+		"completed": info.CompletedSuccessful,
+	})
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pranshuj73/oni/utils"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
@@ -42,6 +43,22 @@ func (l LogLevel) String() string {
 	}
 }
 
+// ParseLevel converts a level name (debug, info, warn, error) into a LogLevel
+func ParseLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return DEBUG, nil
+	case "info":
+		return INFO, nil
+	case "warn":
+		return WARN, nil
+	case "error":
+		return ERROR, nil
+	default:
+		return INFO, fmt.Errorf("unknown log level '%s'", level)
+	}
+}
+
 // Logger is the global logger instance
 type Logger struct {
 	writer      io.Writer
@@ -60,13 +77,13 @@ var (
 func Initialize() error {
 	var initErr error
 	once.Do(func() {
-		homeDir, err := os.UserHomeDir()
+		dataDir, err := utils.DataDir()
 		if err != nil {
-			initErr = fmt.Errorf("failed to get home directory: %w", err)
+			initErr = fmt.Errorf("failed to resolve data directory: %w", err)
 			return
 		}
 
-		logDir := filepath.Join(homeDir, ".oni", "logs")
+		logDir := filepath.Join(dataDir, "logs")
 		if err := os.MkdirAll(logDir, 0755); err != nil {
 			initErr = fmt.Errorf("failed to create log directory: %w", err)
 			return
@@ -102,6 +119,25 @@ func GetLogFilePath() string {
 	return globalLogger.logPath
 }
 
+// ReadTail returns the last n lines of the current log file
+func ReadTail(n int) ([]string, error) {
+	logPath := GetLogFilePath()
+	if logPath == "" {
+		return nil, fmt.Errorf("logger is not initialized")
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log file: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
+
 // SetMinLevel sets the minimum log level to record
 func SetMinLevel(level LogLevel) {
 	if globalLogger != nil {
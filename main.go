@@ -2,13 +2,17 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -34,6 +38,12 @@ const (
 	StateAnimeList
 	StateEpisodeSelect
 	StateAniListAuth
+	StateSeasonBrowse
+	StateQualitySelect
+	StateRecentSelect
+	StateScorePrompt
+	StateTranslationSelect
+	StateRelations
 )
 
 // App represents the main application model
@@ -47,6 +57,11 @@ type App struct {
 	selectedAnime  *anilist.Anime
 	selectedEntry  *anilist.MediaListEntry
 	selectedEp     int
+	specialEp      string // Decimal episode like "6.5"; selectedEp then holds the regular episode before it
+	startAt        string // HH:MM:SS chosen in episode select, used instead of the resume point
+	launchCmd      tea.Cmd // Run at startup for launch_action, nil for the plain menu
+	startupWarning string  // Raised before the UI started, shown as a toast once it's up
+	episodeTitle   string // Episode name from the provider, if it has one
 	subOrDub       string
 	err            error
 	loadingMsg     string        // Central loading message
@@ -59,6 +74,21 @@ type App struct {
 	incognitoMode  bool          // Runtime incognito mode state
 	toastMsg       string        // Transient footer message
 	toastID        int           // Monotonic id to clear the latest toast
+	pendingVideo   *providers.VideoData // Resolved video waiting on a quality pick
+	playing        bool          // Whether the external player is running
+	stopPlayer     context.CancelFunc // Stops the running player
+	quitAfterPlay  bool          // Quit once the stopped player's progress is saved
+	stoppedByUser  bool          // The player was stopped from oni, so the episode doesn't count as finished
+	streamURL      string        // Resolved link of the video last sent to the player, for copying
+	backStack      []screen      // Screens back returns to, most recent last; the main menu is below them all
+}
+
+// screen is a screen that was left for another one, kept as it was so back can return to it
+type screen struct {
+	state  AppState
+	model  tea.Model
+	anime  *anilist.Anime
+	entry  *anilist.MediaListEntry
 }
 
 func main() {
@@ -71,9 +101,14 @@ func main() {
 		provider       = flag.String("w", "", "Provider")
 		subOrDub       = flag.String("sub-or-dub", "", "Sub or dub")
 		discordPresence = flag.Bool("d", false, "Enable Discord presence")
+		noAniList      = flag.Bool("no-anilist", false, "Skip AniList for this run")
+		logLevel       = flag.String("log-level", "", "Log level (debug, info, warn, error)")
+		clearListCache = flag.Bool("clear-list-cache", false, "Clear the cached AniList lists")
+		episode        = flag.Int("ep", 0, "Episode to play for the query, without the menu")
+		doctor         = flag.Bool("doctor", false, "Check the config, player, AniList login and providers")
 	)
 
-	flag.Parse()
+	query := parseArgs()
 
 	// Initialize logger
 	if err := logger.Initialize(); err != nil {
@@ -81,6 +116,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Apply the flag level early so config loading respects it
+	if *logLevel != "" {
+		level, err := logger.ParseLevel(*logLevel)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid log level: %v\n", err)
+			os.Exit(1)
+		}
+		logger.SetMinLevel(level)
+	}
+
 	logger.Info("Application started", map[string]interface{}{
 		"version": version,
 	})
@@ -107,9 +152,43 @@ func main() {
 
 	logger.Info("Configuration loaded", nil)
 
+	// History, caches and the token move to data_dir before anything reads them; the log stays put
+	// since it opens before the config is loaded
+	if dir := cfg.Advanced.DataDir; dir != "" {
+		if filepath.IsAbs(dir) {
+			utils.SetDataDir(dir)
+			logger.Debug("Data directory override applied", map[string]interface{}{
+				"dataDir": dir,
+			})
+		} else {
+			logger.Warn("Ignoring data_dir that is not an absolute path", map[string]interface{}{
+				"dataDir": dir,
+			})
+		}
+	}
+
+	// Providers with a proxy of their own use it from the first request
+	providers.SetProxies(cfg.Provider.Proxies)
+
+	// Start from a clean slate when the list cache got into a bad state; lists are refetched on next load
+	if *clearListCache {
+		if err := ui.ClearListCache(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to clear list cache: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Apply log level from config unless overridden by flag; the flag level was applied above and isn't saved
+	if *logLevel == "" {
+		if level, err := logger.ParseLevel(cfg.Advanced.LogLevel); err == nil {
+			logger.SetMinLevel(level)
+		}
+	}
+
 	// Apply command-line overrides
 	if *quality != "" {
-		cfg.Provider.Quality = *quality
+		// The command-line quality applies to every provider for this session, and isn't saved with the config
+		cfg.Provider.SessionQuality = *quality
 		logger.Debug("Quality override applied", map[string]interface{}{
 			"quality": *quality,
 		})
@@ -130,18 +209,38 @@ func main() {
 		cfg.Discord.DiscordPresence = true
 		logger.Debug("Discord presence enabled via flag", nil)
 	}
+	if *noAniList {
+		// Skips auth, the list cache refresh and progress updates, all of which check this setting
+		cfg.AniList.NoAniList = true
+		logger.Debug("AniList disabled via flag", nil)
+	}
+
+	if *doctor {
+		os.Exit(runDoctor(cfg))
+	}
 
 	// Try to load existing AniList token
 	var client *anilist.Client
 	var needsAuth bool
+	anilist.SetKeyringEnabled(cfg.AniList.TokenStorage == "keyring")
+	utils.SetThresholds(cfg.Playback.CompletionThreshold, cfg.Playback.NextEpisodeThreshold)
+	// With no network, fetching the user and lists would hang; check first and run from local history instead
+	var offline bool
 	if !cfg.AniList.NoAniList {
+		offline = errors.Is(anilist.CheckConnection(context.Background()), anilist.ErrOffline)
+	}
+	if !cfg.AniList.NoAniList && !offline {
 		logger.Debug("Attempting to load AniList token", nil)
 		token, err := anilist.LoadToken()
 		if err == nil && token != "" {
 			logger.Debug("AniList token found, creating client", nil)
 			// Token exists, try to create client
 			client, err = anilist.NewClient()
-			if err != nil {
+			if errors.Is(err, anilist.ErrOffline) {
+				// The connection dropped after the check; a new token wouldn't help
+				client = nil
+				offline = true
+			} else if err != nil {
 				// Token might be invalid, need re-auth
 				logger.Warn("AniList client creation failed, auth required", map[string]interface{}{
 					"error": err.Error(),
@@ -155,19 +254,41 @@ func main() {
 			logger.Debug("No AniList token found, auth required", nil)
 			needsAuth = true
 		}
+	} else if offline {
+		logger.Info("Starting offline, AniList features are off for this run", nil)
 	} else {
 		logger.Info("AniList integration disabled", nil)
 	}
 
+	if *episode < 0 {
+		fmt.Fprintf(os.Stderr, "--ep must be a positive episode number, got %d\n", *episode)
+		os.Exit(1)
+	}
+
+	// A query plays straight away and exits, so oni can be scripted
+	if query != "" {
+		ep := *episode
+		if ep == 0 {
+			ep = 1
+		}
+		os.Exit(runHeadless(cfg, client, query, ep))
+	}
+	if *episode != 0 {
+		fmt.Fprintln(os.Stderr, "--ep needs an anime to search for, e.g. oni \"One Piece\" --ep 1071")
+		os.Exit(1)
+	}
+
 	// Create Discord presence manager
-	discordMgr := discord.NewPresenceManager(cfg.Discord.DiscordPresence)
+	discordMgr := discord.NewPresenceManager(cfg.Discord)
+	startupWarning := ""
 	if cfg.Discord.DiscordPresence {
 		logger.Debug("Attempting to connect to Discord", nil)
 		if err := discordMgr.Connect(); err != nil {
 			logger.Warn("Failed to connect to Discord", map[string]interface{}{
 				"error": err.Error(),
 			})
-			fmt.Fprintf(os.Stderr, "Warning: Failed to connect to Discord: %v\n", err)
+			// The alt screen would hide a line on stderr
+			startupWarning = "Couldn't connect to Discord (details in the log)"
 		} else {
 			logger.Info("Discord connected successfully", nil)
 		}
@@ -185,8 +306,11 @@ func main() {
 
 	// Create and run the app
 	mainMenu := ui.NewMainMenuWithClient(cfg, client)
+	mainMenu.SetOffline(offline)
 	initialState := StateMainMenu
 	var initialModel tea.Model = mainMenu
+	var resumeAutoplay *ui.AutoplaySession
+	launchContinue := false
 	
 	// If we are editing config directly, start in config editor
 	if *editConfig {
@@ -198,6 +322,20 @@ func main() {
 		logger.Info("Starting with AniList auth screen", nil)
 		initialState = StateAniListAuth
 		initialModel = ui.NewAniListAuth(cfg)
+	} else if autoplay := loadAutoplaySession(cfg); autoplay != nil {
+		logger.Info("Offering to resume autoplay from last run", map[string]interface{}{
+			"mediaID": autoplay.Anime.ID,
+			"episode": autoplay.Episode,
+		})
+		initialState = StateMainMenu
+		initialModel = ui.NewAutoplayPrompt(cfg, autoplay.Anime.Title.UserPreferred, autoplay.Episode+1)
+		resumeAutoplay = autoplay
+	} else if cfg.UI.LaunchAction == "continue" && hasWatchHistory() {
+		logger.Info("Continuing the last watched anime (launch_action)", nil)
+		launchContinue = true
+	} else if session := loadSession(cfg); session != nil && session.Screen == ui.SessionAnimeList {
+		logger.Info("Restoring Watch Anime from last session", nil)
+		initialState = StateAnimeList
 	} else {
 		logger.Info("Starting with main menu", nil)
 	}
@@ -211,6 +349,24 @@ func main() {
 		mainMenu:     mainMenu,
 		spinner:      s,
 	}
+	app.startupWarning = startupWarning
+	if initialState == StateAnimeList {
+		app.currentModel = app.newWatchAnimeModel()
+	}
+	if launchContinue {
+		// Same as picking Continue Watching: play the next episode without asking
+		app.loadingMsg = "Finding your next episode..."
+		app.launchCmd = app.fetchContinueWatching(false)
+	}
+	if resumeAutoplay != nil {
+		// The prompt continues after the last finished episode, just like one shown after playback
+		app.selectedAnime = &resumeAutoplay.Anime
+		app.selectedEp = resumeAutoplay.Episode
+		app.subOrDub = resumeAutoplay.SubOrDub
+		if app.subOrDub == "" {
+			app.subOrDub = cfg.Playback.SubOrDub
+		}
+	}
 
 	logger.Info("Starting TUI application", nil)
 
@@ -224,6 +380,10 @@ func main() {
 
 	logger.Info("TUI application closed", nil)
 
+	if cfg.UI.RestoreSession {
+		app.saveSession()
+	}
+
 	// Cleanup
 	if cfg.Discord.DiscordPresence {
 		logger.Debug("Clearing Discord presence", nil)
@@ -234,22 +394,51 @@ func main() {
 }
 
 func (a *App) Init() tea.Cmd {
+	var warning tea.Cmd
+	if a.startupWarning != "" {
+		warning = toastCmd(a.startupWarning, ui.ToastError)
+	}
 	// Get initial window size
 	return tea.Batch(
 		a.currentModel.Init(),
 		tea.WindowSize(),
 		a.spinner.Tick,
+		a.launchCmd,
+		warning,
 	)
 }
 
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case spinner.TickMsg:
-		var cmd tea.Cmd
-		a.spinner, cmd = a.spinner.Update(msg)
-		return a, cmd
+		// Screens run spinners of their own, so only the app's ticks stop here; the rest go to the screen
+		if msg.ID == a.spinner.ID() {
+			var cmd tea.Cmd
+			a.spinner, cmd = a.spinner.Update(msg)
+			return a, cmd
+		}
 
 	case tea.KeyMsg:
+		// While the player runs, ctrl+c stops it and quits once progress is saved
+		if a.playing {
+			if msg.String() == "ctrl+c" && !a.quitAfterPlay {
+				logger.Info("Quit requested during playback, stopping player", nil)
+				a.quitAfterPlay = true
+				a.loadingMsg = "Stopping player..."
+				a.stopPlayer()
+			}
+			// s kills a hung or unwanted player and returns to the menu once progress is saved
+			if msg.String() == "s" && !a.quitAfterPlay && !a.stoppedByUser {
+				logger.Info("Stop requested during playback", nil)
+				a.stoppedByUser = true
+				a.loadingMsg = "Stopping player..."
+				a.stopPlayer()
+			}
+			if msg.String() == "c" {
+				return a, a.copyStreamURL()
+			}
+			return a, nil
+		}
 		if msg.String() == "ctrl+c" {
 			return a, tea.Quit
 		}
@@ -262,15 +451,27 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "enter":
 				// Go to Watch Anime menu
 				a.err = nil
+				a.startAt = ""
+				a.backStack = nil
 				a.state = StateAnimeList
-				a.currentModel = ui.NewAnimeList(a.cfg, a.client)
+				a.currentModel = a.newWatchAnimeModel()
 				return a, a.currentModel.Init()
 			case "esc", "backspace", "m":
 				// Go back to main menu
 				a.err = nil
-				a.state = StateMainMenu
-				a.currentModel = a.mainMenu
-				return a, a.currentModel.Init()
+				a.startAt = ""
+				return a, a.goHome()
+			case "a":
+				if errors.Is(a.err, anilist.ErrInvalidToken) {
+					a.err = nil
+					return a.startReauth()
+				}
+			case "w":
+				if a.canRetryEpisode() {
+					return a.retryWithNextProvider()
+				}
+			case "c":
+				return a, a.copyStreamURL()
 			}
 			return a, nil
 		}
@@ -281,17 +482,29 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ui.AnimeSelectedMsg:
 		a.selectedAnime = &msg.Anime
 		a.selectedEntry = msg.Entry
+		if msg.PlayNext {
+			return a.playNextUnwatched()
+		}
 		return a.handleAnimeSelected(msg.ShowEpisodeSelect)
 
 	case ui.EpisodeReadyMsg:
 		a.selectedEp = msg.Episode
+		a.specialEp = msg.Special
 		a.subOrDub = msg.SubOrDub
+		a.startAt = msg.StartAt
 		a.loadingMsg = "Fetching Episode Info"
 		return a, a.fetchAndPlayEpisode()
 
 	case ui.BackMsg:
 		return a.handleBack()
 
+	case ui.RecentSelectedMsg:
+		entry := msg.Entry
+		showEpisodeSelect := msg.ShowEpisodeSelect
+		return a, a.startLoading("Finding your next episode...", func() tea.Msg {
+			return a.resolveHistoryEntry(entry, showEpisodeSelect)
+		})
+
 	case ui.ToastMsg:
 		a.toastID++
 		styles := ui.DefaultStyles()
@@ -326,11 +539,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.loadingMsg = "" // Clear loading
 		if msg.Err != nil {
 			a.err = msg.Err
-			a.state = StateMainMenu
-			a.currentModel = a.mainMenu
-			return a, a.currentModel.Init() // Re-initialize to refresh continue watching anime
+			return a, a.goHome()
 		}
 		if msg.Entry != nil {
+			a.resumeWith(msg.Provider, msg.SubOrDub)
 			return a.continueFromEntry(*msg.Entry, msg.Episode, msg.ShowEpisodeSelect)
 		}
 
@@ -340,16 +552,56 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.loadingMsg = ""
 			return a, nil
 		}
-		// Video links fetched, now loading episode
+		a.episodeTitle = msg.EpisodeTitle
+		return a.handleVideoReady(msg.VideoData)
+
+	case ui.TranslationSelectedMsg:
+		videoData := a.pendingVideo
+		a.pendingVideo = nil
+		if videoData == nil || a.selectedAnime == nil {
+			return a.handleBack()
+		}
+		if err := providers.SaveTranslation(a.cfg.Provider.Provider, a.selectedAnime.ID, msg.Translation.ID); err != nil {
+			logger.Warn("Failed to save translation choice", map[string]interface{}{
+				"mediaID":     a.selectedAnime.ID,
+				"translation": msg.Translation.ID,
+				"error":       err.Error(),
+			})
+		}
+		logger.Debug("Translation selected", map[string]interface{}{
+			"translation": msg.Translation.Name,
+		})
+		// The links were resolved with the default voiceover, so only a different pick needs a refetch
+		if msg.Translation.ID == videoData.Translations[0].ID {
+			videoData.Translations = nil
+			return a.handleVideoReady(videoData)
+		}
+		a.loadingMsg = "Fetching Episode Info"
+		return a, a.fetchAndPlayEpisode()
+
+	case ui.QualitySelectedMsg:
+		videoData := a.pendingVideo
+		a.pendingVideo = nil
+		if videoData == nil {
+			return a.handleBack()
+		}
+		if link, ok := videoData.Qualities[msg.Quality]; ok {
+			videoData.VideoURL = link
+		}
+		logger.Debug("Quality selected", map[string]interface{}{
+			"quality": msg.Quality,
+		})
 		a.loadingMsg = "Loading Episode"
-		// Trigger play in next update cycle so UI can render "Loading Episode"
 		return a, func() tea.Msg {
-			return PlayVideoMsg{VideoData: msg.VideoData}
+			return PlayVideoMsg{VideoData: videoData}
 		}
 
 	case PlayVideoMsg:
 		// Now actually play the video (UI has rendered "Loading Episode")
 		return a.handlePlayEpisode(msg.VideoData)
+
+	case PlaybackFinishedMsg:
+		return a.handlePlaybackFinished(msg)
 	
 	case ui.AutoplayPromptMsg:
 		// User chose to enable/disable autoplay
@@ -358,19 +610,52 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Continue to next episode
 			return a.playNextEpisode()
 		} else {
+			a.clearAutoplaySession()
 			// Return to main menu
-			a.state = StateMainMenu
-			a.currentModel = a.mainMenu
-			return a, a.currentModel.Init() // Re-initialize to refresh continue watching anime
+			return a, a.goHome()
 		}
 	
+	case ui.ReauthRequestMsg:
+		return a.startReauth()
+
+	case ui.RelationsRequestMsg:
+		return a, a.pushScreen(StateRelations, ui.NewRelations(a.cfg, a.client, msg.Anime))
+
+	case ui.TrailerRequestMsg:
+		return a.fetchTrailer(msg.Anime)
+
+	case TrailerReadyMsg:
+		return a.playTrailer(msg)
+
+	case TrailerFinishedMsg:
+		a.playing = false
+		a.stopPlayer()
+		a.loadingMsg = ""
+		a.streamURL = ""
+		stopped := a.stoppedByUser
+		a.stoppedByUser = false
+		if a.quitAfterPlay {
+			return a, tea.Quit
+		}
+		if msg.Err != nil && !stopped {
+			logger.Error("Failed to play trailer", msg.Err, nil)
+			return a, toastCmd("Couldn't play the trailer", ui.ToastError)
+		}
+		return a, nil
+
 	case ui.AniListAuthSuccessMsg:
 		// Authentication successful, store client and go to main menu
 		a.client = msg.Client
 		a.mainMenu.SetClient(msg.Client)
+		// The cached lists may belong to the old token's account
+		ui.ForceRefreshCacheInBackground(a.cfg, msg.Client)
+		a.backStack = nil
 		a.state = StateMainMenu
 		a.currentModel = a.mainMenu
-		return a, a.currentModel.Init() // Re-initialize to fetch continue watching anime
+		return a, tea.Batch(
+			a.currentModel.Init(), // Re-initialize to fetch continue watching anime
+			func() tea.Msg { return ui.ToastMsg{Text: "Connected to AniList", Kind: ui.ToastSuccess} },
+		)
 
 	case tea.WindowSizeMsg:
 		// Store window size and pass to current model
@@ -399,7 +684,21 @@ func (a *App) View() string {
 			s += styles.Help.Render(fmt.Sprintf("Details saved to: %s", logPath)) + "\n\n"
 		}
 		
+		if hint := errorHint(a.err); hint != "" {
+			s += styles.Info.Render(hint) + "\n\n"
+		}
+
 		s += styles.Prompt.Render("Options:") + "\n"
+		if errors.Is(a.err, anilist.ErrInvalidToken) {
+			s += styles.MenuItem.Render("  a") + " " + styles.Help.Render("→ Re-authenticate AniList") + "\n"
+		}
+		if a.canRetryEpisode() {
+			next := config.NextProvider(a.cfg.Provider.Provider)
+			s += styles.MenuItem.Render("  w") + " " + styles.Help.Render(fmt.Sprintf("→ Retry episode %s with %s", a.episodeLabel(), next)) + "\n"
+		}
+		if a.streamURL != "" {
+			s += styles.MenuItem.Render("  c") + " " + styles.Help.Render("→ Copy the stream URL to test it in another player") + "\n"
+		}
 		s += styles.MenuItem.Render("  Enter") + " " + styles.Help.Render("→ Go to Watch Anime menu") + "\n"
 		s += styles.MenuItem.Render("  Esc/Backspace/m") + " " + styles.Help.Render("→ Go back to main menu") + "\n"
 		s += styles.MenuItem.Render("  q") + " " + styles.Help.Render("→ Quit") + "\n"
@@ -420,6 +719,13 @@ func (a *App) View() string {
 		// Add loading message in green
 		styles := ui.DefaultStyles()
 		view += "\n" + a.spinner.View() + " " + styles.Success.Render(a.loadingMsg)
+		if a.playing && a.streamURL != "" && !a.stoppedByUser && !a.quitAfterPlay {
+			view += styles.Help.Render("  (s: stop player • c: copy stream URL)")
+		}
+		// Toasts raised while the player runs (e.g. "URL copied") go after the loading message
+		if a.toastMsg != "" {
+			view += "  " + a.toastMsg
+		}
 	} else if a.toastMsg != "" {
 		lines := strings.Split(view, "\n")
 		if len(lines) > 0 {
@@ -432,6 +738,13 @@ func (a *App) View() string {
 	return view
 }
 
+// startLoading shows msg on the loading line while cmd runs, kicking the spinner so it animates
+// for the whole wait; a tick from a chain that is already running is dropped by the spinner
+func (a *App) startLoading(msg string, cmd tea.Cmd) tea.Cmd {
+	a.loadingMsg = msg
+	return tea.Batch(a.spinner.Tick, cmd)
+}
+
 func (a *App) handleMenuSelection(selection string, showEpisodeSelect bool) (tea.Model, tea.Cmd) {
 	logger.Debug("Menu selection", map[string]interface{}{
 		"selection":        selection,
@@ -441,13 +754,25 @@ func (a *App) handleMenuSelection(selection string, showEpisodeSelect bool) (tea
 	switch selection {
 	case "Continue Watching":
 		logger.Info("User selected Continue Watching", nil)
-		a.loadingMsg = "Finding your next episode..."
-		return a, a.fetchContinueWatching(showEpisodeSelect)
+		return a, a.startLoading("Finding your next episode...", a.fetchContinueWatching(showEpisodeSelect))
+
+	case "Recent":
+		logger.Info("User selected Recent", nil)
+		a.incognitoMode = a.mainMenu.GetIncognitoMode()
+		a.state = StateRecentSelect
+		a.currentModel = ui.NewRecentSelect(a.cfg, a.incognitoMode)
+		return a, a.currentModel.Init()
 
 	case "Watch Anime":
 		logger.Info("User selected Watch Anime", nil)
 		a.state = StateAnimeList
-		a.currentModel = ui.NewAnimeList(a.cfg, a.client)
+		a.currentModel = a.newWatchAnimeModel()
+		return a, a.currentModel.Init()
+
+	case "Browse Season":
+		logger.Info("User selected Browse Season", nil)
+		a.state = StateSeasonBrowse
+		a.currentModel = ui.NewSeasonBrowse(a.cfg, a.client)
 		return a, a.currentModel.Init()
 
 	case "Update Progress/Status/Score":
@@ -473,9 +798,12 @@ func (a *App) handleMenuSelection(selection string, showEpisodeSelect bool) (tea
 // ContinueWatchingResultMsg is sent when continue watching fetch is complete
 type ContinueWatchingResultMsg struct {
 	Entry            *anilist.MediaListEntry
-	Episode          int // The episode number to play (calculated based on 95% completion)
+	Episode          int // The episode number to play (calculated from the next-episode threshold)
 	ShowEpisodeSelect bool
 	Err              error
+	// Provider and voiceover the entry was last played with, empty when history doesn't know
+	Provider string
+	SubOrDub string
 }
 
 // fetchContinueWatching fetches the anime to continue watching from local history
@@ -501,116 +829,73 @@ func (a *App) fetchContinueWatching(showEpisodeSelect bool) tea.Cmd {
 		}
 
 		// Find the entry with the most recent LastWatched timestamp (same logic as main_menu.go)
-		var lastEntry *player.HistoryEntry
-		var latestTime time.Time
-		
-		for i := range history {
-			entry := &history[i]
-			if entry.Title == "" {
-				continue
-			}
-			
-			// Parse LastWatched timestamp (RFC3339 format)
-			watchedTime, err := time.Parse(time.RFC3339, entry.LastWatched)
-			if err != nil {
-				// If LastWatched is missing or invalid (old format), skip this entry
-				continue
-			}
-			
-			// Check if this is the most recent
-			if lastEntry == nil || watchedTime.After(latestTime) {
-				lastEntry = entry
-				latestTime = watchedTime
-			}
-		}
-		
-		if lastEntry == nil {
-      logger.Debug("No anime found to continue watching.", nil)
+		recent := player.RecentHistory(history, 1)
+		if len(recent) == 0 {
+			logger.Debug("No anime found to continue watching.", nil)
 			return ContinueWatchingResultMsg{
 				Err: fmt.Errorf("no anime found to continue watching"),
 			}
-    } else {
-      logger.Debug("Found last watched anime", map[string]interface{}{
-        "mediaID":  lastEntry.MediaID,
-        "title":    lastEntry.Title,
-        "progress": lastEntry.Progress,
-      })
-    }
-		
-		// Calculate which episode to play based on 95% completion check (same logic as main_menu.go)
-		var episodeToPlay int
-		var isComplete bool
-		
-		if lastEntry.Duration != "" && lastEntry.Timestamp != "" && lastEntry.Timestamp != "00:00:00" {
-			// Parse timestamp (current position)
-			timestampParts := strings.Split(lastEntry.Timestamp, ":")
-			if len(timestampParts) == 3 {
-				hours, _ := strconv.Atoi(timestampParts[0])
-				minutes, _ := strconv.Atoi(timestampParts[1])
-				seconds, _ := strconv.Atoi(timestampParts[2])
-				currentSeconds := hours*3600 + minutes*60 + seconds
-				
-				// Parse duration (total length)
-				durationParts := strings.Split(lastEntry.Duration, ":")
-				if len(durationParts) == 3 {
-					durHours, _ := strconv.Atoi(durationParts[0])
-					durMinutes, _ := strconv.Atoi(durationParts[1])
-					durSeconds, _ := strconv.Atoi(durationParts[2])
-					totalSeconds := durHours*3600 + durMinutes*60 + durSeconds
-					
-					if totalSeconds > 0 {
-						percentage := (float64(currentSeconds) / float64(totalSeconds)) * 100
-						isComplete = utils.IsEpisodeComplete(percentage)
-					}
-				}
-			}
 		}
 
-		// Calculate which episode to play using shared utility
-		var percentage float64
-		if isComplete {
-			percentage = 100.0
-		}
-		episodeToPlay = utils.GetNextEpisode(lastEntry.Progress, lastEntry.EpisodesTotal, percentage)
-		
-		// If AniList is available, fetch full anime info
-		if !a.cfg.AniList.NoAniList && a.client != nil {
-			animeInfo, err := a.client.GetAnimeInfo(context.Background(), lastEntry.MediaID)
-			if err == nil {
-				logger.Info("Fetched anime info from AniList", map[string]interface{}{
-					"mediaID": lastEntry.MediaID,
-				})
-				entry := anilist.MediaListEntry{
-					Media:    *animeInfo,
-					Progress: lastEntry.Progress,
-				}
-				return ContinueWatchingResultMsg{
-					Entry:            &entry,
-					Episode:          episodeToPlay,
-					ShowEpisodeSelect: showEpisodeSelect,
-				}
-			}
-			logger.Warn("Failed to fetch anime info from AniList", map[string]interface{}{
-				"error":   err.Error(),
+		return a.resolveHistoryEntry(recent[0], showEpisodeSelect)
+	}
+}
+
+// resolveHistoryEntry builds a continue watching result for a history entry
+func (a *App) resolveHistoryEntry(lastEntry player.HistoryEntry, showEpisodeSelect bool) ContinueWatchingResultMsg {
+	logger.Debug("Found last watched anime", map[string]interface{}{
+		"mediaID":  lastEntry.MediaID,
+		"title":    lastEntry.Title,
+		"progress": lastEntry.Progress,
+	})
+
+	// Calculate which episode to play based on the next-episode threshold
+	episodeToPlay := lastEntry.NextEpisode()
+
+	// If AniList is available, fetch full anime info
+	if !a.cfg.AniList.NoAniList && a.client != nil {
+		animeInfo, err := a.client.GetAnimeInfo(context.Background(), lastEntry.MediaID)
+		if err == nil {
+			logger.Info("Fetched anime info from AniList", map[string]interface{}{
 				"mediaID": lastEntry.MediaID,
 			})
+			entry := anilist.MediaListEntry{
+				Media:    *animeInfo,
+				Progress: lastEntry.Progress,
+			}
+			return ContinueWatchingResultMsg{
+				Entry:            &entry,
+				Episode:          episodeToPlay,
+				ShowEpisodeSelect: showEpisodeSelect,
+				Provider:         lastEntry.Provider,
+				SubOrDub:         lastEntry.SubOrDub,
+			}
 		}
+		logger.Warn("Failed to fetch anime info from AniList", map[string]interface{}{
+			"error":   err.Error(),
+			"mediaID": lastEntry.MediaID,
+		})
+	}
 
-		// If AniList not available or fetch failed, create a minimal entry from history
-		// This will require searching by title when playing
-		logger.Debug("Using minimal entry from history", nil)
-		entry := anilist.MediaListEntry{
-			Media: anilist.Anime{
-				ID:    lastEntry.MediaID,
-				Title: anilist.Title{English: lastEntry.Title},
-			},
-			Progress: lastEntry.Progress,
-		}
-		return ContinueWatchingResultMsg{
-			Entry:            &entry,
-			Episode:          episodeToPlay,
-			ShowEpisodeSelect: showEpisodeSelect,
-		}
+	// If AniList not available or fetch failed, create a minimal entry from history
+	// This will require searching by title when playing, so stick to the provider it was found on before
+	logger.Debug("Using minimal entry from history", map[string]interface{}{
+		"provider": lastEntry.Provider,
+		"subOrDub": lastEntry.SubOrDub,
+	})
+	entry := anilist.MediaListEntry{
+		Media: anilist.Anime{
+			ID:    lastEntry.MediaID,
+			Title: anilist.Title{English: lastEntry.Title},
+		},
+		Progress: lastEntry.Progress,
+	}
+	return ContinueWatchingResultMsg{
+		Entry:            &entry,
+		Episode:          episodeToPlay,
+		ShowEpisodeSelect: showEpisodeSelect,
+		Provider:         lastEntry.Provider,
+		SubOrDub:         lastEntry.SubOrDub,
 	}
 }
 
@@ -635,6 +920,7 @@ func (a *App) handleAnimeSelected(showEpisodeSelect bool) (tea.Model, tea.Cmd) {
 			nextEp = 1
 		}
 		a.selectedEp = nextEp
+		a.specialEp = ""
 		a.subOrDub = a.cfg.Playback.SubOrDub
 		if a.subOrDub == "" {
 			a.subOrDub = "sub" // Default to sub
@@ -646,15 +932,40 @@ func (a *App) handleAnimeSelected(showEpisodeSelect bool) (tea.Model, tea.Cmd) {
 	}
 
 	// Show episode selection (either requested or no progress available)
-	a.state = StateEpisodeSelect
-	a.currentModel = ui.NewEpisodeSelect(a.cfg, *a.selectedAnime, progress)
-	return a, a.currentModel.Init()
+	return a, a.pushScreen(StateEpisodeSelect, ui.NewEpisodeSelect(a.cfg, *a.selectedAnime, progress))
+}
+
+// playNextUnwatched plays the episode after the list progress without asking, from episode 1 when
+// there is no progress or every episode has been watched
+func (a *App) playNextUnwatched() (tea.Model, tea.Cmd) {
+	nextEp := 1
+	if a.selectedEntry != nil {
+		nextEp = a.selectedEntry.Progress + 1
+	}
+	if a.selectedAnime.Episodes != nil && nextEp > *a.selectedAnime.Episodes {
+		nextEp = 1
+	}
+
+	logger.Info("Playing next unwatched episode", map[string]interface{}{
+		"mediaID": a.selectedAnime.ID,
+		"episode": nextEp,
+	})
+
+	a.selectedEp = nextEp
+	a.specialEp = ""
+	a.subOrDub = a.cfg.Playback.SubOrDub
+	if a.subOrDub == "" {
+		a.subOrDub = "sub" // Default to sub
+	}
+	a.loadingMsg = "Fetching Episode Info"
+	return a, a.fetchAndPlayEpisode()
 }
 
 // PlayEpisodeResultMsg is sent when episode is ready to play
 type PlayEpisodeResultMsg struct {
-	VideoData *providers.VideoData
-	Err       error
+	VideoData    *providers.VideoData
+	EpisodeTitle string
+	Err          error
 }
 
 // PlayVideoMsg is sent to trigger actual video playback (after UI renders "Loading Episode")
@@ -662,6 +973,42 @@ type PlayVideoMsg struct {
 	VideoData *providers.VideoData
 }
 
+// handleVideoReady asks for a voiceover or quality when needed, then starts playback
+func (a *App) handleVideoReady(videoData *providers.VideoData) (tea.Model, tea.Cmd) {
+	title := a.playerTitle()
+
+	// Let the user pick a voiceover when the provider offers several
+	if len(videoData.Translations) > 1 {
+		a.loadingMsg = ""
+		a.pendingVideo = videoData
+		return a, a.pushScreen(StateTranslationSelect, ui.NewTranslationSelect(a.cfg, title, videoData.Translations))
+	}
+
+	// Let the user pick a quality when configured to ask
+	quality := a.cfg.QualityFor(a.cfg.Provider.Provider)
+	if (quality == "" || quality == "ask") && len(videoData.Qualities) > 1 {
+		a.loadingMsg = ""
+		a.pendingVideo = videoData
+		return a, a.pushScreen(StateQualitySelect, ui.NewQualitySelect(a.cfg, title, providers.SortedQualities(videoData.Qualities)))
+	}
+
+	// Video links fetched, now loading episode
+	a.loadingMsg = "Loading Episode"
+	// Trigger play in next update cycle so UI can render "Loading Episode"
+	return a, func() tea.Msg {
+		return PlayVideoMsg{VideoData: videoData}
+	}
+}
+
+// PlaybackFinishedMsg is sent when the external player exits
+type PlaybackFinishedMsg struct {
+	Info         *player.PlaybackInfo
+	Err          error
+	Title        string
+	ResumeFrom   string
+	HistoryEntry *player.HistoryEntry // History for this episode from before playback, if any
+}
+
 // fetchAndPlayEpisode fetches episode info and video links, then plays
 func (a *App) fetchAndPlayEpisode() tea.Cmd {
 	return func() tea.Msg {
@@ -675,7 +1022,7 @@ func (a *App) fetchAndPlayEpisode() tea.Cmd {
 			"title":    a.selectedAnime.Title.UserPreferred,
 			"episode":  a.selectedEp,
 			"provider": a.cfg.Provider.Provider,
-			"quality":  a.cfg.Provider.Quality,
+			"quality":  a.cfg.QualityFor(a.cfg.Provider.Provider),
 			"subOrDub": a.subOrDub,
 		})
 
@@ -688,12 +1035,23 @@ func (a *App) fetchAndPlayEpisode() tea.Cmd {
 			return PlayEpisodeResultMsg{Err: err}
 		}
 
+		// Apply the per-anime offset for shows numbered differently on the provider
+		providerEp := a.episodeLabel()
+		if offset := providers.LoadEpisodeOffset(a.cfg.Provider.Provider, a.selectedAnime.ID); offset != 0 {
+			providerEp = providers.OffsetEpisode(providerEp, offset)
+			logger.Debug("Applying episode offset", map[string]interface{}{
+				"episode":         a.episodeLabel(),
+				"offset":          offset,
+				"providerEpisode": providerEp,
+			})
+		}
+
 		// Get episode info
-		epInfo, err := prov.GetEpisodeInfo(context.Background(), a.selectedAnime.ID, a.selectedEp, a.selectedAnime.Title.UserPreferred)
+		epInfo, err := prov.GetEpisodeInfo(context.Background(), a.selectedAnime.ID, providerEp, a.selectedAnime.Title.Names())
 		if err != nil {
 			logger.Error("Failed to get episode info", err, map[string]interface{}{
 				"mediaID":  a.selectedAnime.ID,
-				"episode":  a.selectedEp,
+				"episode":  providerEp,
 				"provider": a.cfg.Provider.Provider,
 			})
 			return PlayEpisodeResultMsg{Err: fmt.Errorf("failed to get episode info: %w", err)}
@@ -704,11 +1062,11 @@ func (a *App) fetchAndPlayEpisode() tea.Cmd {
 		})
 
 		// Get video link
-		videoData, err := prov.GetVideoLink(context.Background(), epInfo, a.cfg.Provider.Quality, a.subOrDub)
+		videoData, err := prov.GetVideoLink(context.Background(), epInfo, a.cfg.QualityFor(a.cfg.Provider.Provider), a.subOrDub)
 		if err != nil {
 			logger.Error("Failed to get video link", err, map[string]interface{}{
 				"episodeID": epInfo.EpisodeID,
-				"quality":   a.cfg.Provider.Quality,
+				"quality":   a.cfg.QualityFor(a.cfg.Provider.Provider),
 				"subOrDub":  a.subOrDub,
 			})
 			return PlayEpisodeResultMsg{Err: fmt.Errorf("failed to get video link: %w", err)}
@@ -718,7 +1076,7 @@ func (a *App) fetchAndPlayEpisode() tea.Cmd {
 			"hasSubtitles": len(videoData.SubtitleURLs) > 0,
 		})
 
-		return PlayEpisodeResultMsg{VideoData: videoData}
+		return PlayEpisodeResultMsg{VideoData: videoData, EpisodeTitle: epInfo.EpisodeTitle}
 	}
 }
 
@@ -735,100 +1093,274 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 		"episode": a.selectedEp,
 	})
 
-	// Set Discord presence (only if not in incognito mode)
 	a.incognitoMode = a.mainMenu.GetIncognitoMode()
+
+	// Get player
+	plyr, err := player.GetPlayer(a.cfg)
+	if err != nil {
+		logger.Error("Failed to get player", err, map[string]interface{}{
+			"player": a.cfg.Player.Player,
+		})
+		a.err = err
+		a.loadingMsg = ""
+		return a, nil
+	}
+
+	// Check for resume point (only if episode was not already completed)
+	resumeFrom := "00:00:00"
+	historyEntry, _ := player.GetHistoryEntryWithIncognito(a.selectedAnime.ID, a.selectedEp, a.incognitoMode)
+	if historyEntry != nil {
+		resumeFrom = player.DecideResume(*historyEntry)
+		logger.Debug("Resume point found", map[string]interface{}{
+			"timestamp": resumeFrom,
+		})
+	}
+
+	// A start time picked in episode select wins over the resume point, for this episode only
+	if a.startAt != "" {
+		resumeFrom = a.startAt
+		a.startAt = ""
+	}
+
+	// Set Discord presence (only if not in incognito mode)
 	if a.cfg.Discord.DiscordPresence && a.discordMgr.IsEnabled() && !a.incognitoMode {
 		year := 0
 		if a.selectedAnime.StartDate.Year != nil {
 			year = *a.selectedAnime.StartDate.Year
 		}
+
+		// Duration is only known if we've watched this episode before
+		var duration, position time.Duration
+		if historyEntry != nil {
+			if durSeconds, ok := utils.ParseTimestamp(historyEntry.Duration); ok {
+				duration = time.Duration(durSeconds) * time.Second
+			}
+		}
+		if posSeconds, ok := utils.ParseTimestamp(resumeFrom); ok {
+			position = time.Duration(posSeconds) * time.Second
+		}
+
 		logger.Debug("Setting Discord presence", map[string]interface{}{
 			"title":   a.selectedAnime.Title.UserPreferred,
 			"episode": a.selectedEp,
 		})
 		a.discordMgr.SetPresence(
+			a.selectedAnime.ID,
 			a.selectedAnime.Title.UserPreferred,
 			a.selectedEp,
 			year,
 			a.selectedAnime.CoverImage.Large,
+			duration,
+			position,
 		)
 	}
 
-	// Get player
+	// Play video in a command so resize and spinner updates keep flowing while the player runs
+	a.loadingMsg = "Playing Episode" + qualitySummary(videoData)
+	a.playing = true
+	a.streamURL = videoData.VideoURL
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopPlayer = cancel
+	title := a.playerTitle()
+	return a, tea.Batch(playerFallbackToast(a.cfg), runPlayer(ctx, plyr, videoData, title, resumeFrom, func(playbackInfo *player.PlaybackInfo, err error) tea.Msg {
+		return PlaybackFinishedMsg{
+			Info:         playbackInfo,
+			Err:          err,
+			Title:        title,
+			ResumeFrom:   resumeFrom,
+			HistoryEntry: historyEntry,
+		}
+	}))
+}
+
+// runPlayer plays the video in a command and reports the outcome through done
+// The print player writes to the terminal, so the TUI hands the terminal over until enter is pressed
+func runPlayer(ctx context.Context, plyr player.Player, videoData *providers.VideoData, title, resumeFrom string, done func(*player.PlaybackInfo, error) tea.Msg) tea.Cmd {
+	printer, ok := plyr.(*player.PrintPlayer)
+	if !ok {
+		return func() tea.Msg {
+			return done(plyr.Play(ctx, videoData, title, resumeFrom))
+		}
+	}
+
+	var playbackInfo *player.PlaybackInfo
+	run := func(in io.Reader, out io.Writer) error {
+		printer.SetTerminal(in, out)
+		var err error
+		playbackInfo, err = printer.Play(ctx, videoData, title, resumeFrom)
+		return err
+	}
+	return tea.Exec(&terminalCommand{run: run}, func(err error) tea.Msg {
+		return done(playbackInfo, err)
+	})
+}
+
+// terminalCommand runs a function with the terminal while the TUI is suspended by tea.Exec
+type terminalCommand struct {
+	run    func(in io.Reader, out io.Writer) error
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+func (c *terminalCommand) Run() error              { return c.run(c.stdin, c.stdout) }
+func (c *terminalCommand) SetStdin(in io.Reader)   { c.stdin = in }
+func (c *terminalCommand) SetStdout(out io.Writer) { c.stdout = out }
+func (c *terminalCommand) SetStderr(io.Writer)     {}
+
+// TrailerReadyMsg carries the trailer link looked up for an anime
+type TrailerReadyMsg struct {
+	Title string
+	URL   string
+	Err   error
+}
+
+// TrailerFinishedMsg is sent when the trailer player exits
+type TrailerFinishedMsg struct {
+	Err error
+}
+
+// playerFallbackToast says which player is used when the configured one isn't installed, or nil when it is
+func playerFallbackToast(cfg *config.Config) tea.Cmd {
+	name, found := config.DetectPlayer(cfg.Player.Player)
+	if !found || name == cfg.Player.Player {
+		return nil
+	}
+	return toastCmd(fmt.Sprintf("%s isn't installed, playing in %s", cfg.Player.Player, name), ui.ToastInfo)
+}
+
+// toastCmd shows a toast from the app itself
+func toastCmd(text string, kind ui.ToastKind) tea.Cmd {
+	return func() tea.Msg {
+		return ui.ToastMsg{Text: text, Kind: kind}
+	}
+}
+
+// qualitySummary describes the stream's quality for the loading message,
+// e.g. " (available: 1080, 720, 480; playing 720)", or "" when the provider listed none
+func qualitySummary(videoData *providers.VideoData) string {
+	available := videoData.AvailableQualities()
+	if len(available) == 0 {
+		return ""
+	}
+	summary := "available: " + strings.Join(available, ", ")
+	if playing := videoData.PlayingQuality(); playing != "" {
+		summary += "; playing " + playing
+	}
+	return " (" + summary + ")"
+}
+
+// copyStreamURL puts the resolved stream link on the clipboard so it can be tried by hand
+func (a *App) copyStreamURL() tea.Cmd {
+	if a.streamURL == "" {
+		return nil
+	}
+	if err := clipboard.WriteAll(a.streamURL); err != nil {
+		logger.Error("Failed to copy stream URL", err, nil)
+		return toastCmd(fmt.Sprintf("Couldn't copy the URL: %v", err), ui.ToastError)
+	}
+	logger.Debug("Copied stream URL to clipboard", nil)
+	return toastCmd("URL copied", ui.ToastSuccess)
+}
+
+// fetchTrailer looks up the anime's trailer on AniList
+func (a *App) fetchTrailer(anime anilist.Anime) (tea.Model, tea.Cmd) {
+	if a.client == nil {
+		return a, toastCmd("Trailers need AniList", ui.ToastError)
+	}
+	a.loadingMsg = "Looking for a trailer"
+	client := a.client
+	return a, func() tea.Msg {
+		info, err := client.GetAnimeInfo(context.Background(), anime.ID)
+		if err != nil {
+			return TrailerReadyMsg{Title: anime.Title.UserPreferred, Err: err}
+		}
+		return TrailerReadyMsg{Title: anime.Title.UserPreferred, URL: info.Trailer.URL()}
+	}
+}
+
+// playTrailer opens the trailer in the configured player without touching history or progress
+func (a *App) playTrailer(msg TrailerReadyMsg) (tea.Model, tea.Cmd) {
+	a.loadingMsg = ""
+	if msg.Err != nil {
+		logger.Error("Failed to look up trailer", msg.Err, map[string]interface{}{
+			"title": msg.Title,
+		})
+		return a, toastCmd("Couldn't look up the trailer", ui.ToastError)
+	}
+	if msg.URL == "" {
+		return a, toastCmd("No trailer available", ui.ToastError)
+	}
+
 	plyr, err := player.GetPlayer(a.cfg)
 	if err != nil {
 		logger.Error("Failed to get player", err, map[string]interface{}{
 			"player": a.cfg.Player.Player,
 		})
-		a.err = err
-		a.loadingMsg = ""
-		return a, nil
-	}
-
-	// Check for resume point (only if episode was not already completed)
-	resumeFrom := "00:00:00"
-	historyEntry, _ := player.GetHistoryEntryWithIncognito(a.selectedAnime.ID, a.selectedEp, a.incognitoMode)
-	if historyEntry != nil && historyEntry.Timestamp != "" && historyEntry.Timestamp != "00:00:00" {
-		// We need the actual duration to calculate time remaining
-		// If duration is not available, start from beginning
-		if historyEntry.Duration == "" {
-			resumeFrom = "00:00:00"
-		} else {
-			// Parse timestamp to check if it's near the end
-			// Format: HH:MM:SS
-			parts := strings.Split(historyEntry.Timestamp, ":")
-			if len(parts) == 3 {
-				hours, _ := strconv.Atoi(parts[0])
-				minutes, _ := strconv.Atoi(parts[1])
-				seconds, _ := strconv.Atoi(parts[2])
-				currentSeconds := hours*3600 + minutes*60 + seconds
-				
-				// Parse duration from history entry (HH:MM:SS format)
-				durationParts := strings.Split(historyEntry.Duration, ":")
-				if len(durationParts) == 3 {
-					durHours, _ := strconv.Atoi(durationParts[0])
-					durMinutes, _ := strconv.Atoi(durationParts[1])
-					durSeconds, _ := strconv.Atoi(durationParts[2])
-					totalDurationSeconds := durHours*3600 + durMinutes*60 + durSeconds
-					
-					timeRemaining := totalDurationSeconds - currentSeconds
-					
-					// If less than 1 minute remaining, start from beginning to avoid immediate completion
-					if timeRemaining < 60 && currentSeconds > 0 {
-						resumeFrom = "00:00:00"
-					} else if currentSeconds > 30 {
-						// Resume from the saved timestamp if it's reasonable
-						resumeFrom = historyEntry.Timestamp
-					}
-					// If currentSeconds <= 30, start from beginning (too early to resume)
-				} else {
-					// Invalid duration format, start from beginning
-					resumeFrom = "00:00:00"
-				}
-			} else {
-				// Invalid timestamp format, start from beginning
-				resumeFrom = "00:00:00"
-			}
+		if errors.Is(err, player.ErrPlayerNotInstalled) {
+			return a, toastCmd(err.Error(), ui.ToastError)
 		}
-    
-    logger.Debug("Resume point found", map[string]interface{}{
-			"timestamp": resumeFrom,
-		})
+		return a, toastCmd("Couldn't start the player", ui.ToastError)
 	}
 
-	// Play video
-	a.loadingMsg = "Playing Episode"
-	title := fmt.Sprintf("%s - Episode %d", a.selectedAnime.Title.UserPreferred, a.selectedEp)
-	playbackInfo, err := plyr.Play(context.Background(), videoData, title, resumeFrom)
-	a.loadingMsg = "" // Clear loading after play starts
-	if err != nil {
-		logger.Error("Failed to play video", err, map[string]interface{}{
-			"title":   title,
+	logger.Info("Playing trailer", map[string]interface{}{
+		"title": msg.Title,
+		"url":   msg.URL,
+	})
+	a.loadingMsg = "Playing Trailer"
+	a.playing = true
+	a.streamURL = msg.URL
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopPlayer = cancel
+	title := msg.Title + " - Trailer"
+	return a, tea.Batch(playerFallbackToast(a.cfg), runPlayer(ctx, plyr, &providers.VideoData{VideoURL: msg.URL}, title, "", func(_ *player.PlaybackInfo, err error) tea.Msg {
+		return TrailerFinishedMsg{Err: err}
+	}))
+}
+
+// handlePlaybackFinished records history and progress once the player exits, then picks the next screen
+func (a *App) handlePlaybackFinished(msg PlaybackFinishedMsg) (tea.Model, tea.Cmd) {
+	// Playback always ends on the main menu or a prompt that leads to it, so there's nothing to go back to
+	a.backStack = nil
+	a.playing = false
+	a.stopPlayer()
+	a.loadingMsg = "" // Clear loading after play ends
+	stopped := a.stoppedByUser
+	a.stoppedByUser = false
+	if msg.Err != nil {
+		if a.quitAfterPlay {
+			return a, tea.Quit
+		}
+		// Players that report being killed as an error (e.g. vlc) were stopped on purpose
+		if stopped {
+			a.streamURL = ""
+			a.autoplayMode = false
+			return a, a.goHome()
+		}
+		logger.Error("Failed to play video", msg.Err, map[string]interface{}{
+			"title":   msg.Title,
 			"player":  a.cfg.Player.Player,
 		})
-		a.err = fmt.Errorf("failed to play video: %w", err)
+		a.err = fmt.Errorf("failed to play video: %w", msg.Err)
 		return a, nil
 	}
+	// Only a failed stream stays copyable from the error screen
+	a.streamURL = ""
+	if a.selectedAnime == nil {
+		logger.Error("No anime selected when playback finished", nil, nil)
+		return a.handleBack()
+	}
+
+	playbackInfo := msg.Info
+	resumeFrom := msg.ResumeFrom
+	historyEntry := msg.HistoryEntry
+
+	// A killed player may still have reached the completion threshold; the position is kept, but
+	// the episode isn't counted and autoplay doesn't go on
+	if stopped {
+		info := *playbackInfo
+		info.CompletedSuccessful = false
+		playbackInfo = &info
+	}
   
   logger.Info("Playback completed", map[string]interface{}{
 		"completedSuccessful": playbackInfo.CompletedSuccessful,
@@ -837,7 +1369,8 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 	})
 
 	// Save history entry when episode starts
-	episodesTotal := 9999
+	// 0 records an unknown episode count
+	episodesTotal := 0
 	if a.selectedAnime.Episodes != nil {
 		episodesTotal = *a.selectedAnime.Episodes
 	}
@@ -859,11 +1392,22 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 		Duration:      startDuration,
 		LastWatched:   startLastWatched,
 		Title:         a.selectedAnime.Title.UserPreferred,
+		Provider:      a.cfg.Provider.Provider,
+		SubOrDub:      a.subOrDub,
 	}
 
+	// Failures the user should hear about; stderr is hidden under the TUI, so they are shown as a toast
+	var warnings []string
+	historySaved := true
+
 	// Save to incognito or normal history based on current mode
 	if err := player.SaveHistoryEntryWithIncognito(startEntry, a.incognitoMode); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: Failed to save history on start: %v\n", err)
+		logger.Error("Failed to save history on start", err, map[string]interface{}{
+			"mediaID":       a.selectedAnime.ID,
+			"episode":       a.selectedEp,
+			"incognitoMode": a.incognitoMode,
+		})
+		historySaved = false
 	}
 
 	// Update history entry with the actual playback position and duration
@@ -886,6 +1430,8 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 			Duration:      duration,
 			LastWatched:   lastWatched,
 			Title:         a.selectedAnime.Title.UserPreferred,
+			Provider:      a.cfg.Provider.Provider,
+			SubOrDub:      a.subOrDub,
 		}
 
 		// Update history entry with actual playback position
@@ -895,7 +1441,7 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 				"episode":        a.selectedEp,
 				"incognitoMode": a.incognitoMode,
 			})
-			fmt.Fprintf(os.Stderr, "Warning: Failed to save history after playback: %v\n", err)
+			historySaved = false
     } else {
       logger.Info("History saved", map[string]interface{}{
 				"mediaID":        a.selectedAnime.ID,
@@ -905,10 +1451,44 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
     }
 	}
 
+	if !historySaved {
+		warnings = append(warnings, "Couldn't save watch history")
+	}
+
 	// Update AniList progress separately (if enabled, episode completed, and NOT in incognito mode)
-	if playbackInfo.CompletedSuccessful && !a.cfg.AniList.NoAniList && !a.incognitoMode && a.client != nil {
+	// Specials like 6.5 don't count towards AniList progress
+	seriesCompleted := false
+	// Incognito leaves AniList alone unless private_incognito_updates asks for private updates instead
+	private := a.incognitoMode
+	// Toast telling the user what happened, shown on whichever screen comes next
+	var notice tea.Cmd
+	updateAniList := playbackInfo.CompletedSuccessful && !a.cfg.AniList.NoAniList && (!a.incognitoMode || a.cfg.AniList.PrivateIncognitoUpdates) && a.client != nil && a.specialEp == ""
+
+	// Saving progress adds a show that isn't on the user's lists, so only do that when auto_add_on_play allows it
+	// Without cached lists there's no telling, so the show is taken to be on them
+	adding := false
+	// Entries built from history (Continue Watching, Recent) have no status, so they are checked too
+	if updateAniList && (a.selectedEntry == nil || a.selectedEntry.Status == "") {
+		if onList, known := ui.OnAniListList(a.selectedAnime.ID); known && !onList {
+			adding = a.cfg.AniList.AutoAddOnPlay
+			if !adding {
+				updateAniList = false
+				logger.Info("Skipping AniList update for a show not on the user's lists", map[string]interface{}{
+					"mediaID": a.selectedAnime.ID,
+				})
+				notice = toastCmd(a.selectedAnime.Title.UserPreferred+" isn't on your AniList lists, progress is kept locally", ui.ToastInfo)
+			}
+		}
+	}
+
+	if updateAniList {
+		finished := a.selectedAnime.Episodes != nil && a.selectedEp >= *a.selectedAnime.Episodes
+		// A rewatch stays REPEATING; finishing it bumps the rewatch count instead of completing the show
+		rewatching := a.selectedEntry != nil && a.selectedEntry.Status == "REPEATING"
 		status := "CURRENT"
-		if a.selectedAnime.Episodes != nil && a.selectedEp >= *a.selectedAnime.Episodes {
+		if rewatching {
+			status = "REPEATING"
+		} else if finished {
 			status = "COMPLETED"
 		}
 
@@ -916,26 +1496,54 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 			"mediaID": a.selectedAnime.ID,
 			"episode": a.selectedEp,
 			"status":  status,
+			"private": private,
 		})
 
-		err := a.client.UpdateProgress(context.Background(), a.selectedAnime.ID, a.selectedEp, status)
+		var err error
+		if rewatching && finished {
+			err = a.client.UpdateRewatch(context.Background(), a.selectedAnime.ID, a.selectedEp, a.selectedEntry.Repeat+1, private)
+			if err == nil {
+				a.selectedEntry.Repeat++
+			}
+		} else if private {
+			err = a.client.UpdateProgressPrivately(context.Background(), a.selectedAnime.ID, a.selectedEp, status)
+		} else {
+			err = a.client.UpdateProgress(context.Background(), a.selectedAnime.ID, a.selectedEp, status)
+		}
 		if err != nil {
 			logger.Error("Failed to update AniList progress", err, map[string]interface{}{
 				"mediaID": a.selectedAnime.ID,
 				"episode": a.selectedEp,
 			})
-			fmt.Fprintf(os.Stderr, "Warning: Failed to update AniList progress: %v\n", err)
+			warnings = append(warnings, "Couldn't update AniList progress")
 		} else {
 			logger.Info("AniList progress updated", map[string]interface{}{
 				"mediaID": a.selectedAnime.ID,
 				"episode": a.selectedEp,
 				"status":  status,
+				"added":   adding,
 			})
+			seriesCompleted = status == "COMPLETED"
+			if adding {
+				// Refresh the lists so the show is found on them from now on
+				ui.ForceRefreshCacheInBackground(a.cfg, a.client)
+				notice = toastCmd("Added "+a.selectedAnime.Title.UserPreferred+" to your AniList lists", ui.ToastSuccess)
+			}
 		}
 		// Note: We don't delete from local history even if AniList marks it as completed
 		// Local history is independent and preserved at all times
 	}
 
+	// Failures win over the AniList notice, since they are the ones that need acting on
+	if len(warnings) > 0 {
+		notice = toastCmd(strings.Join(warnings, " • ")+" (details in the log)", ui.ToastError)
+	}
+
+	// Progress is saved, so a quit requested during playback can go ahead
+	if a.quitAfterPlay {
+		return a, tea.Quit
+	}
+
 	// Check if episode was completed successfully
 	if playbackInfo.CompletedSuccessful {
 		// Check if there are more episodes
@@ -952,10 +1560,11 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 				// Show autoplay prompt
 				a.state = StateMainMenu
 				a.currentModel = ui.NewAutoplayPrompt(a.cfg, a.selectedAnime.Title.UserPreferred, a.selectedEp+1)
-				return a, a.currentModel.Init()
+				return a, tea.Batch(a.currentModel.Init(), notice)
 			} else if a.autoplayMode {
 				// Continue to next episode automatically
-				return a.playNextEpisode()
+				model, cmd := a.playNextEpisode()
+				return model, tea.Batch(cmd, notice)
 			}
 		}
 	}
@@ -965,20 +1574,49 @@ func (a *App) handlePlayEpisode(videoData *providers.VideoData) (tea.Model, tea.
 		a.discordMgr.Clear()
 	}
 
+	// A run that reached the last episode is over; an interrupted one stays saved so it can be resumed
+	if a.autoplayMode && playbackInfo.CompletedSuccessful {
+		a.clearAutoplaySession()
+	}
+
 	// Reset autoplay mode when returning to main menu
 	a.autoplayMode = false
 
+	// Ask for a score once the last episode is done
+	if seriesCompleted && a.cfg.AniList.ScoreOnCompletion {
+		logger.Info("Prompting for score on completion", map[string]interface{}{
+			"mediaID": a.selectedAnime.ID,
+		})
+		a.state = StateScorePrompt
+		a.currentModel = ui.NewScorePrompt(a.cfg, a.client, a.selectedAnime.ID, a.selectedAnime.Title.UserPreferred)
+		return a, tea.Batch(a.currentModel.Init(), notice)
+	}
+
 	// Return to main menu
-	a.state = StateMainMenu
-	a.currentModel = a.mainMenu
-	return a, a.currentModel.Init() // Re-initialize to refresh continue watching anime
+	return a, tea.Batch(a.goHome(), notice)
+}
+
+// resumeWith switches to the provider and voiceover a history entry was last played with, for this session only
+// Unknown values (history from before they were recorded, or a provider since removed) keep the configured ones
+func (a *App) resumeWith(provider, subOrDub string) {
+	a.subOrDub = ""
+	if subOrDub == "sub" || subOrDub == "dub" {
+		a.subOrDub = subOrDub
+	}
+	if config.IsProvider(provider) && provider != a.cfg.Provider.Provider {
+		logger.Info("Resuming with the provider last used", map[string]interface{}{
+			"from": a.cfg.Provider.Provider,
+			"to":   provider,
+		})
+		a.cfg.Provider.Provider = provider
+	}
 }
 
 func (a *App) continueFromEntry(entry anilist.MediaListEntry, episode int, showEpisodeSelect bool) (tea.Model, tea.Cmd) {
 	a.selectedAnime = &entry.Media
 	a.selectedEntry = &entry
 
-	// Use the episode number calculated in fetchContinueWatching (based on 95% completion)
+	// Use the episode number calculated in fetchContinueWatching (based on the next-episode threshold)
 	if episode < 1 {
 		episode = 1
 	}
@@ -987,17 +1625,18 @@ func (a *App) continueFromEntry(entry anilist.MediaListEntry, episode int, showE
 	}
 
 	a.selectedEp = episode
-	a.subOrDub = a.cfg.Playback.SubOrDub
+	a.specialEp = ""
+	if a.subOrDub == "" {
+		a.subOrDub = a.cfg.Playback.SubOrDub
+	}
 	if a.subOrDub == "" {
 		a.subOrDub = "sub"
 	}
 
 	if showEpisodeSelect {
-		a.state = StateEpisodeSelect
-		// Use the calculated episode (based on 95% completion) as the initial progress
+		// Use the calculated episode (based on the next-episode threshold) as the initial progress
 		// This ensures the episode selection matches what the menu displayed
-		a.currentModel = ui.NewEpisodeSelect(a.cfg, entry.Media, episode-1)
-		return a, a.currentModel.Init()
+		return a, a.pushScreen(StateEpisodeSelect, ui.NewEpisodeSelect(a.cfg, entry.Media, episode-1))
 	}
 
 	a.loadingMsg = "Fetching Episode Info"
@@ -1038,16 +1677,18 @@ func (a *App) playNextEpisode() (tea.Model, tea.Cmd) {
 	a.lastAnimeID = a.selectedAnime.ID
 	a.lastWatchTime = time.Now()
 
-	// Increment episode
+	a.saveAutoplaySession()
+
+	// Increment episode; after a special like 6.5 the next one is 7
 	a.selectedEp++
+	a.specialEp = ""
 
 	// Check if we've reached the end
 	if a.selectedAnime.Episodes != nil && a.selectedEp > *a.selectedAnime.Episodes {
 		// No more episodes
 		a.autoplayMode = false
-		a.state = StateMainMenu
-		a.currentModel = a.mainMenu
-		return a, a.currentModel.Init() // Re-initialize to refresh continue watching anime
+		a.clearAutoplaySession()
+		return a, a.goHome()
 	}
 
 	// Fetch and play next episode
@@ -1055,13 +1696,190 @@ func (a *App) playNextEpisode() (tea.Model, tea.Cmd) {
 	return a, a.fetchAndPlayEpisode()
 }
 
-func (a *App) handleBack() (tea.Model, tea.Cmd) {
+// episodeLabel returns the episode being played as shown to the user and sent to providers
+func (a *App) episodeLabel() string {
+	if a.specialEp != "" {
+		return a.specialEp
+	}
+	return strconv.Itoa(a.selectedEp)
+}
+
+// playerTitle is the media title shown by the player, with the episode name when the provider has one
+func (a *App) playerTitle() string {
+	// Providers without episode names fill in a generic "Episode N"
+	if a.episodeTitle == "" || strings.HasPrefix(a.episodeTitle, "Episode ") {
+		return fmt.Sprintf("%s - Episode %s", a.selectedAnime.Title.UserPreferred, a.episodeLabel())
+	}
+	return fmt.Sprintf("%s - %s: %s", a.selectedAnime.Title.UserPreferred, a.episodeLabel(), a.episodeTitle)
+}
+
+// canRetryEpisode reports whether there is an episode to retry from the error screen
+func (a *App) canRetryEpisode() bool {
+	return a.selectedAnime != nil && a.selectedEp > 0
+}
+
+// retryWithNextProvider switches to the next provider for this session and retries the episode
+func (a *App) retryWithNextProvider() (tea.Model, tea.Cmd) {
+	previous := a.cfg.Provider.Provider
+	a.cfg.Provider.Provider = config.NextProvider(previous)
+	logger.Info("Retrying episode with next provider", map[string]interface{}{
+		"from":    previous,
+		"to":      a.cfg.Provider.Provider,
+		"mediaID": a.selectedAnime.ID,
+		"episode": a.selectedEp,
+	})
+	a.err = nil
+	a.loadingMsg = fmt.Sprintf("Retrying with %s", a.cfg.Provider.Provider)
+	return a, a.fetchAndPlayEpisode()
+}
+
+// errorHint suggests what to try next for errors the user can do something about
+func errorHint(err error) string {
+	switch {
+	case errors.Is(err, anilist.ErrInvalidToken):
+		return "Your AniList token may have expired. Re-authenticate to get a new one."
+	case errors.Is(err, providers.ErrEpisodeNotFound):
+		return "This episode may not be released yet on this provider. Try another provider (press w)."
+	case errors.Is(err, providers.ErrShowNotFound), errors.Is(err, providers.ErrNoMapping):
+		return "This provider doesn't seem to have this anime. Try another provider (press w)."
+	case errors.Is(err, providers.ErrNoVideoLinks):
+		return "No playable streams were found. The episode may not be out yet, or try another provider (press w)."
+	}
+	return ""
+}
+
+// newWatchAnimeModel returns the Watch Anime screen
+// Without AniList there are no lists to show, so it goes straight to a provider search
+func (a *App) newWatchAnimeModel() tea.Model {
+	if a.cfg.AniList.NoAniList || a.client == nil {
+		return ui.NewAnimeSearch(a.cfg, a.client)
+	}
+	return ui.NewAnimeList(a.cfg, a.client)
+}
+
+// hasWatchHistory reports whether there is anything for launch_action = continue to resume
+// Without history oni opens on the menu rather than an error
+func hasWatchHistory() bool {
+	history, err := player.LoadHistory()
+	return err == nil && len(history) > 0
+}
+
+// loadSession returns the saved session when restore_session is enabled
+func loadSession(cfg *config.Config) *ui.Session {
+	if !cfg.UI.RestoreSession {
+		return nil
+	}
+	return ui.LoadSession()
+}
+
+// saveSession records the screen on display so the next run can reopen it
+// Screens opened from the list count as the list, since that's where going back leads
+func (a *App) saveSession() {
+	screen := ui.SessionMainMenu
+	switch a.state {
+	case StateAnimeList, StateEpisodeSelect, StateRelations:
+		screen = ui.SessionAnimeList
+	}
+	if err := ui.SaveSession(screen, a.currentModel); err != nil {
+		logger.Warn("Failed to save session", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// loadAutoplaySession returns the interrupted autoplay run when resume_autoplay is enabled
+func loadAutoplaySession(cfg *config.Config) *ui.AutoplaySession {
+	if !cfg.Playback.ResumeAutoplay {
+		return nil
+	}
+	return ui.LoadAutoplaySession()
+}
+
+// saveAutoplaySession records the episode just finished so an interrupted autoplay run can be resumed
+func (a *App) saveAutoplaySession() {
+	if !a.cfg.Playback.ResumeAutoplay || a.incognitoMode {
+		return
+	}
+	session := ui.AutoplaySession{
+		Anime:    *a.selectedAnime,
+		Episode:  a.selectedEp,
+		SubOrDub: a.subOrDub,
+	}
+	if err := ui.SaveAutoplaySession(session); err != nil {
+		logger.Warn("Failed to save autoplay session", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// clearAutoplaySession forgets the saved autoplay run once it is finished or declined
+func (a *App) clearAutoplaySession() {
+	if !a.cfg.Playback.ResumeAutoplay {
+		return
+	}
+	if err := ui.ClearAutoplaySession(); err != nil {
+		logger.Warn("Failed to clear autoplay session", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// startReauth opens the AniList authentication screen to replace the saved token
+func (a *App) startReauth() (tea.Model, tea.Cmd) {
+	logger.Info("Starting AniList re-authentication", nil)
+	return a, a.pushScreen(StateAniListAuth, ui.NewAniListReauth(a.cfg))
+}
+
+// pushScreen opens model over the current screen, which back then returns to
+// The main menu isn't pushed since back always ends there, and a voiceover or quality prompt is
+// replaced rather than returned to
+func (a *App) pushScreen(state AppState, model tea.Model) tea.Cmd {
+	if a.currentModel != a.mainMenu && a.state != StateTranslationSelect && a.state != StateQualitySelect {
+		a.backStack = append(a.backStack, screen{
+			state: a.state,
+			model: a.currentModel,
+			anime: a.selectedAnime,
+			entry: a.selectedEntry,
+		})
+	}
+	a.state = state
+	a.currentModel = model
+	return model.Init()
+}
+
+// goHome shows the main menu and forgets the screens that led away from it
+func (a *App) goHome() tea.Cmd {
+	a.backStack = nil
 	a.state = StateMainMenu
 	a.currentModel = a.mainMenu
+	return a.currentModel.Init() // Re-initialize to refresh continue watching anime
+}
+
+// handleBack returns to the screen the current one was opened from, or to the main menu when there is none
+func (a *App) handleBack() (tea.Model, tea.Cmd) {
+	a.startAt = ""
+	a.err = nil
+
+	if n := len(a.backStack); n > 0 {
+		previous := a.backStack[n-1]
+		a.backStack = a.backStack[:n-1]
+		a.state = previous.state
+		a.currentModel = previous.model
+		a.selectedAnime = previous.anime
+		a.selectedEntry = previous.entry
+
+		// Re-initialize to pick up anything missed while hidden, including a resize
+		cmds := []tea.Cmd{a.currentModel.Init()}
+		if a.width > 0 {
+			size := tea.WindowSizeMsg{Width: a.width, Height: a.height}
+			cmds = append(cmds, func() tea.Msg { return size })
+		}
+		return a, tea.Batch(cmds...)
+	}
+
 	a.selectedAnime = nil
 	a.selectedEntry = nil
-	a.err = nil
-	return a, a.currentModel.Init() // Re-initialize to refresh continue watching anime
+	return a, a.goHome()
 }
 
 func showUsage() {
@@ -1075,13 +1893,21 @@ Options:
   -h             Show this help
   -q <quality>   Video quality (e.g., 1080, 720)
   -v             Show version
-  -w <provider>  Provider (allanime, aniwatch, yugen, hdrezka, aniworld)
+  -w <provider>  Provider (allanime, aniwatch, yugen, hdrezka, aniworld, gogoanime, animepahe)
   --sub-or-dub   Audio type (sub, dub)
+  --no-anilist   Don't use AniList for this run (no login, list sync or progress updates)
+  --log-level    Log level (debug, info, warn, error)
+  --clear-list-cache  Clear the cached AniList lists and refetch them
+  --ep <episode> Episode to play for [query] (default 1)
+  --doctor       Check the config, player, AniList login and every provider
 
 Examples:
   oni                         # Start interactive menu
   oni -q 720                  # Set quality to 720p
   oni -w aniwatch             # Use aniwatch provider
+  oni "One Piece" --ep 1071   # Play episode 1071 of One Piece and exit
+
+Exit codes with a query: 0 played, 1 error, 2 anime not found, 3 episode unavailable
 
 `)
 }
@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pranshuj73/oni/config"
 	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // HistoryEntry represents a watch history entry
@@ -22,8 +25,15 @@ type HistoryEntry struct {
 	Duration      string `json:"duration"`       // Total duration of the episode (HH:MM:SS format)
 	LastWatched   string `json:"last_watched"`   // Last watched timestamp (when you last completed an episode)
 	Title         string `json:"title"`
+	// Provider and voiceover the episode was played with, empty in history from before version 2
+	Provider string `json:"provider,omitempty"`
+	SubOrDub string `json:"sub_or_dub,omitempty"`
 }
 
+// historyVersion is the current history file format version
+// Version 2 added the provider and sub_or_dub fields; older files load as is with those left empty
+const historyVersion = 2
+
 // HistoryFile represents the JSON history file structure
 type HistoryFile struct {
 	Version int            `json:"version"` // File format version for future migrations
@@ -148,9 +158,13 @@ func migrateOldHistoryFormat(data string) ([]HistoryEntry, error) {
 			continue
 		}
 
-		episodesTotal, err := strconv.Atoi(progressParts[1])
-		if err != nil {
-			continue
+		// "?" marks an unknown episode count, stored as 0
+		episodesTotal := 0
+		if progressParts[1] != "?" {
+			episodesTotal, err = strconv.Atoi(progressParts[1])
+			if err != nil {
+				continue
+			}
 		}
 
 		timestamp := parts[2]
@@ -276,7 +290,7 @@ func SaveHistoryEntryWithIncognito(entry HistoryEntry, incognito bool) error {
 // saveHistoryToFile saves history entries to a JSON file with atomic write
 func saveHistoryToFile(historyPath string, entries []HistoryEntry) error {
 	historyFile := HistoryFile{
-		Version: 1,
+		Version: historyVersion,
 		Entries: entries,
 	}
 
@@ -377,7 +391,8 @@ func GetHistoryEntryWithIncognito(mediaID int, episode int, incognito bool) (*Hi
 	}
 
 	for _, e := range entries {
-		if e.MediaID == mediaID && e.Progress == episode {
+		// An entry saved with progress 0 is episode 1 left mid-way, so its timestamp is still resumed
+		if e.MediaID == mediaID && max(e.Progress, 1) == episode {
 			return &e, nil
 		}
 	}
@@ -385,3 +400,122 @@ func GetHistoryEntryWithIncognito(mediaID int, episode int, incognito bool) (*Hi
 	return nil, nil
 }
 
+
+// WatchedPercentage returns how far into the episode the entry was left
+// Returns false when the timestamp or duration is missing or unparsable
+func (e HistoryEntry) WatchedPercentage() (float64, bool) {
+	currentSeconds, ok := utils.ParseTimestamp(e.Timestamp)
+	if !ok {
+		return 0, false
+	}
+	totalSeconds, ok := utils.ParseTimestamp(e.Duration)
+	if !ok || totalSeconds <= 0 {
+		return 0, false
+	}
+
+	percentage := (float64(currentSeconds) / float64(totalSeconds)) * 100
+	if percentage > 100 {
+		percentage = 100
+	}
+	return percentage, true
+}
+
+// NextEpisode returns the episode to resume from, moving on once the last one was completed
+func (e HistoryEntry) NextEpisode() int {
+	percentage, _ := e.WatchedPercentage()
+	return utils.GetNextEpisode(e.Progress, e.EpisodesTotal, percentage)
+}
+
+// Resuming only makes sense between these points: earlier is barely started, later would end right away
+const (
+	resumeMinSeconds       = 30 // Positions up to this start the episode over
+	resumeEndMarginSeconds = 60 // Positions with less than this left start the episode over
+)
+
+// DecideResume returns the HH:MM:SS position to play an entry's episode from, "00:00:00" to start over
+// It resumes at the saved position unless that is in the first 30 seconds or the last minute, or
+// can't be trusted because the timestamp or duration is missing or unparsable
+func DecideResume(entry HistoryEntry) string {
+	const start = "00:00:00"
+	currentSeconds, ok := utils.ParseTimestamp(entry.Timestamp)
+	if !ok {
+		return start
+	}
+	// Without the duration there is no telling whether the position is near the end
+	totalSeconds, ok := utils.ParseTimestamp(entry.Duration)
+	if !ok {
+		return start
+	}
+	if currentSeconds <= resumeMinSeconds || totalSeconds-currentSeconds < resumeEndMarginSeconds {
+		return start
+	}
+	return entry.Timestamp
+}
+
+// RecentHistory returns up to limit entries ordered by most recently watched
+// Entries without a title or a valid LastWatched timestamp are skipped
+func RecentHistory(entries []HistoryEntry, limit int) []HistoryEntry {
+	type watched struct {
+		entry HistoryEntry
+		at    time.Time
+	}
+
+	var recent []watched
+	for _, entry := range entries {
+		if entry.Title == "" {
+			continue
+		}
+		// Old history format has no LastWatched, so we can't order it
+		watchedTime, err := time.Parse(time.RFC3339, entry.LastWatched)
+		if err != nil {
+			continue
+		}
+		recent = append(recent, watched{entry: entry, at: watchedTime})
+	}
+
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recent[i].at.After(recent[j].at)
+	})
+
+	if limit > 0 && len(recent) > limit {
+		recent = recent[:limit]
+	}
+
+	result := make([]HistoryEntry, len(recent))
+	for i, r := range recent {
+		result[i] = r.entry
+	}
+	return result
+}
+
+// ClearHistory removes all watch history entries, optionally deleting incognito history too
+func ClearHistory(includeIncognito bool) error {
+	logger.Debug("Clearing watch history", map[string]interface{}{
+		"includeIncognito": includeIncognito,
+	})
+
+	historyPath, err := GetHistoryPath()
+	if err != nil {
+		return err
+	}
+
+	if err := saveHistoryToFile(historyPath, []HistoryEntry{}); err != nil {
+		logger.Error("Failed to clear history file", err, map[string]interface{}{
+			"path": historyPath,
+		})
+		return fmt.Errorf("failed to clear history: %w", err)
+	}
+
+	if includeIncognito {
+		if err := DeleteIncognitoHistory(); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Watch history cleared", map[string]interface{}{
+		"path":             historyPath,
+		"includeIncognito": includeIncognito,
+	})
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package player
+
+import "testing"
+
+func TestDecideResume(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry HistoryEntry
+		want  string
+	}{
+		{"no history", HistoryEntry{}, "00:00:00"},
+		{"partial watch", HistoryEntry{Timestamp: "00:12:34", Duration: "00:24:00"}, "00:12:34"},
+		{"barely started", HistoryEntry{Timestamp: "00:00:30", Duration: "00:24:00"}, "00:00:00"},
+		{"completed episode", HistoryEntry{Timestamp: "00:23:50", Duration: "00:24:00"}, "00:00:00"},
+		{"played to the end", HistoryEntry{Timestamp: "00:24:00", Duration: "00:24:00"}, "00:00:00"},
+		{"unknown duration", HistoryEntry{Timestamp: "00:12:34"}, "00:00:00"},
+		{"unparsable timestamp", HistoryEntry{Timestamp: "12:34", Duration: "00:24:00"}, "00:00:00"},
+		{"unparsable duration", HistoryEntry{Timestamp: "00:12:34", Duration: "24m"}, "00:00:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DecideResume(tt.entry); got != tt.want {
+				t.Errorf("DecideResume(%+v) = %q, want %q", tt.entry, got, tt.want)
+			}
+		})
+	}
+}
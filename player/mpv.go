@@ -14,6 +14,7 @@ import (
 	"github.com/pranshuj73/oni/config"
 	"github.com/pranshuj73/oni/logger"
 	"github.com/pranshuj73/oni/providers"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // MPVPlayer implements MPV player
@@ -49,6 +50,9 @@ func (p *MPVPlayer) Play(ctx context.Context, videoData *providers.VideoData, ti
 
 	args := []string{videoData.VideoURL}
 
+	// Subtitle styling goes before the custom arguments, so --sub-* flags set there still win
+	args = append(args, subtitleStyleArgs(p.cfg)...)
+
 	// Add custom player arguments
 	if p.cfg.Player.PlayerArguments != "" {
 		customArgs := strings.Fields(p.cfg.Player.PlayerArguments)
@@ -185,6 +189,18 @@ func (p *MPVPlayer) Play(ctx context.Context, videoData *providers.VideoData, ti
 	return playbackInfo, nil
 }
 
+// subtitleStyleArgs returns the mpv flags for the configured subtitle size and color
+func subtitleStyleArgs(cfg *config.Config) []string {
+	var args []string
+	if cfg.Player.SubtitleFontSize > 0 {
+		args = append(args, fmt.Sprintf("--sub-font-size=%d", cfg.Player.SubtitleFontSize))
+	}
+	if cfg.Player.SubtitleColor != "" {
+		args = append(args, "--sub-color="+cfg.Player.SubtitleColor)
+	}
+	return args
+}
+
 // parseOutput parses MPV output to extract playback information
 func (p *MPVPlayer) parseOutput(filePath string) (*PlaybackInfo, error) {
 	file, err := os.Open(filePath)
@@ -199,6 +215,9 @@ func (p *MPVPlayer) parseOutput(filePath string) (*PlaybackInfo, error) {
 
 	// Regular expression to match: AV: 00:01:23 / 00:24:56 (5%)
 	re := regexp.MustCompile(`AV:\s+([0-9:]+)\s+/\s+([0-9:]+)\s+\(([0-9]+)%\)`)
+	// mpv says why it exited, e.g. "Exiting... (End of file)" or "Exiting... (Quit)"
+	reExit := regexp.MustCompile(`Exiting\.\.\. \(([^)]*)\)`)
+	var exitReason string
 
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
@@ -209,6 +228,9 @@ func (p *MPVPlayer) parseOutput(filePath string) (*PlaybackInfo, error) {
 			lastTotalDuration = matches[2] // Extract total duration
 			lastPercentage, _ = strconv.Atoi(matches[3])
 		}
+		if exitMatch := reExit.FindStringSubmatch(line); exitMatch != nil {
+			exitReason = exitMatch[1]
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -223,11 +245,24 @@ func (p *MPVPlayer) parseOutput(filePath string) (*PlaybackInfo, error) {
 		}, nil
 	}
 
+	// The episode counts once it reached completion_threshold and, when mpv says why it exited, also
+	// played to the end, so scrubbing near the end and quitting doesn't bump progress. Without an exit
+	// line (e.g. mpv was killed) the percentage decides alone
+	completed := utils.IsEpisodeWatched(float64(lastPercentage))
+	if exitReason != "" {
+		completed = completed && exitReason == "End of file"
+	}
+	logger.Debug("Parsed MPV exit", map[string]interface{}{
+		"exitReason": exitReason,
+		"percentage": lastPercentage,
+		"completed":  completed,
+	})
+
 	return &PlaybackInfo{
 		StoppedAt:           lastPosition,
 		TotalDuration:       lastTotalDuration,
 		PercentageProgress:  lastPercentage,
-		CompletedSuccessful: lastPercentage >= 85,
+		CompletedSuccessful: completed,
 	}, nil
 }
 
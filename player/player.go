@@ -2,6 +2,7 @@ package player
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"github.com/pranshuj73/oni/config"
 	"github.com/pranshuj73/oni/logger"
 	"github.com/pranshuj73/oni/providers"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // Player defines the interface for video players
@@ -28,12 +30,35 @@ type PlaybackInfo struct {
 	CompletedSuccessful bool
 }
 
+// ErrPlayerNotInstalled is returned when neither the configured player nor a fallback is on PATH
+var ErrPlayerNotInstalled = errors.New("is not installed or not on PATH — install it or set a different player in Settings")
+
 // GetPlayer returns a player by name
 func GetPlayer(cfg *config.Config) (Player, error) {
 	logger.Debug("Getting player", map[string]interface{}{
 		"player": cfg.Player.Player,
 	})
 
+	// Fail up front rather than with a bare exec error once the episode has loaded
+	name, found := config.DetectPlayer(cfg.Player.Player)
+	if !found {
+		logger.Error("Player not installed", nil, map[string]interface{}{
+			"player": cfg.Player.Player,
+		})
+		return nil, fmt.Errorf("%s %w", cfg.Player.Player, ErrPlayerNotInstalled)
+	}
+
+	// Fall back to an installed player for this run; the config keeps its setting
+	if name != cfg.Player.Player {
+		logger.Warn("Configured player not found on PATH, using another", map[string]interface{}{
+			"configured": cfg.Player.Player,
+			"using":      name,
+		})
+		detected := *cfg
+		detected.Player.Player = name
+		cfg = &detected
+	}
+
 	switch cfg.Player.Player {
 	case "mpv", "mpv.exe":
 		logger.Info("Using MPV player", nil)
@@ -44,6 +69,12 @@ func GetPlayer(cfg *config.Config) (Player, error) {
 	case "iina":
 		logger.Info("Using IINA player", nil)
 		return NewIINAPlayer(cfg), nil
+	case "remote-mpv":
+		logger.Info("Using remote MPV player", nil)
+		return NewRemoteMPVPlayer(cfg), nil
+	case "print":
+		logger.Info("Using print player", nil)
+		return NewPrintPlayer(cfg), nil
 	default:
 		logger.Error("Unknown player", nil, map[string]interface{}{
 			"player": cfg.Player.Player,
@@ -66,32 +97,34 @@ func GetHistoryPathWithConfig(cfg *config.Config) (string, error) {
 
 // GetHistoryPathWithIncognito returns the path to the history file (incognito or normal)
 func GetHistoryPathWithIncognito(incognito bool) (string, error) {
-	homeDir, err := os.UserHomeDir()
+	dataDir, err := utils.DataDir()
 	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	dataDir := filepath.Join(homeDir, ".oni")
-	if err := os.MkdirAll(dataDir, 0755); err != nil {
-		return "", fmt.Errorf("failed to create data directory: %w", err)
+		return "", fmt.Errorf("failed to resolve data directory: %w", err)
 	}
 
 	// Use incognito history if incognito mode is enabled
+	name := "history.txt"
 	if incognito {
-		return filepath.Join(dataDir, "incognito_history.txt"), nil
+		name = "incognito_history.txt"
 	}
 
-	return filepath.Join(dataDir, "history.txt"), nil
+	historyPath := filepath.Join(dataDir, name)
+	if err := utils.MigrateLegacyFile(historyPath, name); err != nil {
+		logger.Warn("Failed to migrate history file", map[string]interface{}{
+			"path":  historyPath,
+			"error": err.Error(),
+		})
+	}
+	return historyPath, nil
 }
 
 // DeleteIncognitoHistory deletes the incognito history file
 func DeleteIncognitoHistory() error {
-	homeDir, err := os.UserHomeDir()
+	incognitoPath, err := GetHistoryPathWithIncognito(true)
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return err
 	}
 
-	incognitoPath := filepath.Join(homeDir, ".oni", "incognito_history.txt")
 	if err := os.Remove(incognitoPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to delete incognito history: %w", err)
 	}
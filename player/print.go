@@ -0,0 +1,87 @@
+package player
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/providers"
+)
+
+// PrintPlayer prints the resolved stream instead of playing it, for ssh and other setups without a display
+// The link, referer and subtitles can then be opened in a player somewhere else
+type PrintPlayer struct {
+	cfg *config.Config
+	in  io.Reader // When set, Play waits for enter so the output can be read before oni carries on
+	out io.Writer
+}
+
+// NewPrintPlayer creates a new print player writing to stdout
+func NewPrintPlayer(cfg *config.Config) *PrintPlayer {
+	return &PrintPlayer{
+		cfg: cfg,
+		out: os.Stdout,
+	}
+}
+
+// Name returns the player name
+func (p *PrintPlayer) Name() string {
+	return "print"
+}
+
+// SetTerminal sends the output to out and makes Play wait for enter on in before returning
+func (p *PrintPlayer) SetTerminal(in io.Reader, out io.Writer) {
+	p.in = in
+	p.out = out
+}
+
+// Play prints the stream details along with an mpv command that plays them
+// Nothing is watched here, so the episode is never counted and the resume point is kept
+func (p *PrintPlayer) Play(ctx context.Context, videoData *providers.VideoData, title string, resumeFrom string) (*PlaybackInfo, error) {
+	logger.Info("Printing stream instead of playing", map[string]interface{}{
+		"title":          title,
+		"hasReferer":     videoData.Referer != "",
+		"subtitlesCount": len(videoData.SubtitleURLs),
+	})
+
+	mpvArgs := []string{shellQuote(videoData.VideoURL), shellQuote("--force-media-title=" + title)}
+
+	fmt.Fprintf(p.out, "\n%s\n\n", title)
+	fmt.Fprintf(p.out, "URL:       %s\n", videoData.VideoURL)
+	if videoData.Referer != "" {
+		fmt.Fprintf(p.out, "Referer:   %s\n", videoData.Referer)
+		mpvArgs = append(mpvArgs, shellQuote("--http-header-fields-append=Referer:"+videoData.Referer))
+	}
+	for _, subtitle := range videoData.SubtitleURLs {
+		fmt.Fprintf(p.out, "Subtitles: %s\n", subtitle)
+		mpvArgs = append(mpvArgs, shellQuote("--sub-file="+subtitle))
+	}
+	if resumeFrom != "" && resumeFrom != "00:00:00" {
+		fmt.Fprintf(p.out, "Resume at: %s\n", resumeFrom)
+		mpvArgs = append(mpvArgs, "--start="+resumeFrom)
+	}
+	fmt.Fprintf(p.out, "\nmpv %s\n", strings.Join(mpvArgs, " "))
+
+	if p.in != nil {
+		fmt.Fprint(p.out, "\nPress enter to return to oni ")
+		bufio.NewReader(p.in).ReadString('\n')
+	}
+
+	stoppedAt := resumeFrom
+	if stoppedAt == "" {
+		stoppedAt = "00:00:00"
+	}
+	return &PlaybackInfo{
+		StoppedAt: stoppedAt,
+	}, nil
+}
+
+// shellQuote quotes s for a POSIX shell, so a printed command can be pasted as is
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,246 @@
+package player
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/providers"
+	"github.com/pranshuj73/oni/utils"
+)
+
+// remoteDialTimeout bounds connecting to the remote mpv's IPC socket
+const remoteDialTimeout = 10 * time.Second
+
+// Property observer IDs used with mpv's observe_property
+const (
+	observeTimePos = iota + 1
+	observeDuration
+	observePercentPos
+)
+
+// RemoteMPVPlayer casts to an mpv instance that is already running elsewhere, through its JSON IPC socket
+// mpv only listens on a local socket (--input-ipc-server), so reaching another host needs a bridge
+// such as `socat TCP-LISTEN:9000,fork UNIX-CONNECT:/tmp/mpvsocket` on that host
+type RemoteMPVPlayer struct {
+	cfg *config.Config
+}
+
+// NewRemoteMPVPlayer creates a new remote MPV player
+func NewRemoteMPVPlayer(cfg *config.Config) *RemoteMPVPlayer {
+	return &RemoteMPVPlayer{
+		cfg: cfg,
+	}
+}
+
+// Name returns the player name
+func (p *RemoteMPVPlayer) Name() string {
+	return "remote-mpv"
+}
+
+// remoteSocket returns the IPC address from player_arguments (socket=host:port or socket=/path/to/socket)
+func remoteSocket(playerArguments string) string {
+	for _, field := range strings.Fields(playerArguments) {
+		if socket, ok := strings.CutPrefix(field, "socket="); ok {
+			return socket
+		}
+	}
+	return ""
+}
+
+// mpvCommand is a request sent over mpv's JSON IPC
+type mpvCommand struct {
+	Command   []interface{} `json:"command"`
+	RequestID int           `json:"request_id,omitempty"`
+}
+
+// mpvMessage is a reply or event read from mpv's JSON IPC
+type mpvMessage struct {
+	Event     string          `json:"event"`
+	Reason    string          `json:"reason"`
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	Data      json.RawMessage `json:"data"`
+	Error     string          `json:"error"`
+	RequestID int             `json:"request_id"`
+}
+
+// Play sends the video to the remote mpv and follows playback until the file ends
+func (p *RemoteMPVPlayer) Play(ctx context.Context, videoData *providers.VideoData, title string, resumeFrom string) (*PlaybackInfo, error) {
+	socket := remoteSocket(p.cfg.Player.PlayerArguments)
+	if socket == "" {
+		return nil, fmt.Errorf("remote-mpv needs player_arguments = socket=host:port")
+	}
+
+	// Anything that looks like a path is a unix socket, e.g. one forwarded over ssh
+	network := "tcp"
+	if strings.HasPrefix(socket, "/") {
+		network = "unix"
+	}
+
+	logger.Info("Casting to remote MPV", map[string]interface{}{
+		"socket":       socket,
+		"title":        title,
+		"resumeFrom":   resumeFrom,
+		"hasSubtitles": len(videoData.SubtitleURLs) > 0,
+	})
+
+	conn, err := net.DialTimeout(network, socket, remoteDialTimeout)
+	if err != nil {
+		logger.Error("Failed to connect to remote MPV", err, map[string]interface{}{
+			"socket": socket,
+		})
+		return nil, fmt.Errorf("failed to connect to remote mpv at %s: %w", socket, err)
+	}
+	defer conn.Close()
+
+	encoder := json.NewEncoder(conn)
+	send := func(requestID int, args ...interface{}) error {
+		if err := encoder.Encode(mpvCommand{Command: args, RequestID: requestID}); err != nil {
+			return fmt.Errorf("failed to send %v to remote mpv: %w", args[0], err)
+		}
+		return nil
+	}
+
+	// Per-file settings go in first; they stay set on the remote, so reset the ones we don't use
+	start := "none"
+	if resumeFrom != "" && resumeFrom != "00:00:00" {
+		start = resumeFrom
+	}
+	headers := []string{}
+	if videoData.Referer != "" {
+		headers = append(headers, "Referer: "+videoData.Referer)
+	}
+	setup := [][]interface{}{
+		{"set_property", "force-media-title", title},
+		{"set_property", "start", start},
+		{"set_property", "http-header-fields", headers},
+		{"observe_property", observeTimePos, "time-pos"},
+		{"observe_property", observeDuration, "duration"},
+		{"observe_property", observePercentPos, "percent-pos"},
+	}
+	// Subtitle styling is only sent when configured, so the remote's own settings apply otherwise
+	if p.cfg.Player.SubtitleFontSize > 0 {
+		setup = append(setup, []interface{}{"set_property", "sub-font-size", p.cfg.Player.SubtitleFontSize})
+	}
+	if p.cfg.Player.SubtitleColor != "" {
+		setup = append(setup, []interface{}{"set_property", "sub-color", p.cfg.Player.SubtitleColor})
+	}
+	for _, args := range setup {
+		if err := send(0, args...); err != nil {
+			return nil, err
+		}
+	}
+
+	const loadRequestID = 1
+	if err := send(loadRequestID, "loadfile", videoData.VideoURL, "replace"); err != nil {
+		return nil, err
+	}
+
+	// Read replies and events in the background so ctx can interrupt the wait
+	messages := make(chan mpvMessage)
+	readErr := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		scanner := bufio.NewScanner(conn)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var msg mpvMessage
+			if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+				continue
+			}
+			select {
+			case messages <- msg:
+			case <-done:
+				return
+			}
+		}
+		readErr <- scanner.Err()
+	}()
+
+	var position, duration, percent float64
+	started := false
+	endReason := ""
+
+wait:
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping remote MPV playback", nil)
+			send(0, "stop")
+			endReason = "stop"
+			break wait
+
+		case err := <-readErr:
+			// The remote mpv quit or the bridge went away
+			logger.Warn("Remote MPV connection closed", map[string]interface{}{
+				"error": fmt.Sprintf("%v", err),
+			})
+			break wait
+
+		case msg := <-messages:
+			switch {
+			case msg.RequestID == loadRequestID:
+				if msg.Error != "success" {
+					return nil, fmt.Errorf("remote mpv couldn't load the video: %s", msg.Error)
+				}
+			case msg.Event == "start-file":
+				// Events before this belong to whatever the remote was playing
+				started = true
+				position, duration, percent = 0, 0, 0
+			case msg.Event == "file-loaded":
+				for _, subtitle := range videoData.SubtitleURLs {
+					send(0, "sub-add", subtitle)
+				}
+			case msg.Event == "property-change":
+				var value float64
+				if json.Unmarshal(msg.Data, &value) != nil {
+					continue
+				}
+				switch msg.ID {
+				case observeTimePos:
+					position = value
+				case observeDuration:
+					duration = value
+				case observePercentPos:
+					percent = value
+				}
+			case msg.Event == "end-file" && started:
+				endReason = msg.Reason
+				break wait
+			}
+		}
+	}
+
+	// Like the local mpv, an episode counts once it reached completion_threshold and played to the
+	// end; without an end event (connection lost) the percentage decides alone
+	completed := utils.IsEpisodeWatched(percent)
+	if endReason != "" {
+		completed = completed && endReason == "eof"
+	}
+
+	info := &PlaybackInfo{
+		StoppedAt:           utils.FormatTimestamp(int(position)),
+		PercentageProgress:  int(percent),
+		CompletedSuccessful: completed,
+	}
+	if duration > 0 {
+		info.TotalDuration = utils.FormatTimestamp(int(duration))
+	}
+
+	logger.Info("Remote MPV playback finished", map[string]interface{}{
+		"endReason":           endReason,
+		"stoppedAt":           info.StoppedAt,
+		"percentageProgress":  info.PercentageProgress,
+		"completedSuccessful": info.CompletedSuccessful,
+	})
+
+	return info, nil
+}
@@ -14,10 +14,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
-	"sort"
-	"strconv"
 	"strings"
-	"time"
 )
 
 const (
@@ -33,19 +30,8 @@ type AllAnimeProvider struct {
 
 // NewAllAnimeProvider creates a new AllAnime provider
 func NewAllAnimeProvider() *AllAnimeProvider {
-	// Configure HTTP client with timeout and connection pooling
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-	}
-
 	return &AllAnimeProvider{
-		client: &http.Client{
-			Timeout:   60 * time.Second,
-			Transport: transport,
-		},
+		client: newHTTPClient("allanime"),
 	}
 }
 
@@ -55,34 +41,126 @@ func (p *AllAnimeProvider) Name() string {
 }
 
 // GetEpisodeInfo searches for anime and returns episode info
-func (p *AllAnimeProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error) {
+func (p *AllAnimeProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	title := primaryTitle(titles)
+
 	// Check cache first
 	cached, err := LoadProviderMapping("allanime", mediaID)
 	if err == nil && cached != nil {
-		// Use cached provider ID
+		// Use cached provider ID; availability is a nice-to-have, so a failed lookup is ignored
+		availability, _ := p.showAvailability(ctx, cached.ProviderID)
 		return &EpisodeInfo{
-			EpisodeID:    fmt.Sprintf("%d", episodeNum),
-			EpisodeTitle: fmt.Sprintf("Episode %d", episodeNum),
+			EpisodeID:    episode,
+			EpisodeTitle: "Episode " + episode,
 			ShowID:       cached.ProviderID,
+			Availability: availability,
 		}, nil
 	}
 
-	// Search for the anime — POST with JSON body (matching jerry.sh)
+	shows, err := p.searchShows(ctx, title, "sub")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shows) == 0 {
+		return nil, fmt.Errorf("%w for: %s", ErrShowNotFound, title)
+	}
+
+	// Find best matching show — allanime's ranking doesn't always put the closest match first
+	names := make([]string, len(shows))
+	for i, edge := range shows {
+		names[i] = edge.Name
+	}
+	show := shows[bestTitleMatch(names, titles)]
+
+	// Save to cache
+	SaveProviderMapping("allanime", mediaID, show.ID, title)
+
+	return &EpisodeInfo{
+		EpisodeID:    episode,
+		EpisodeTitle: "Episode " + episode,
+		ShowID:       show.ID,
+		Availability: show.availability(),
+	}, nil
+}
+
+// allAnimeShow is a single show from allanime's shows query
+type allAnimeShow struct {
+	ID                string `json:"_id"`
+	Name              string `json:"name"`
+	AvailableEpisodes struct {
+		Sub int `json:"sub"`
+		Dub int `json:"dub"`
+	} `json:"availableEpisodes"`
+}
+
+// availability converts the show's episode counts
+func (s allAnimeShow) availability() *Availability {
+	return &Availability{Sub: s.AvailableEpisodes.Sub, Dub: s.AvailableEpisodes.Dub}
+}
+
+// searchShows runs allanime's shows query
+func (p *AllAnimeProvider) searchShows(ctx context.Context, query string, translationType string) ([]allAnimeShow, error) {
 	searchQuery := `query($search: SearchInput, $limit: Int, $page: Int, $translationType: VaildTranslationTypeEnumType, $countryOrigin: VaildCountryOriginEnumType) { shows(search: $search, limit: $limit, page: $page, translationType: $translationType, countryOrigin: $countryOrigin) { edges { _id name availableEpisodes __typename } } }`
 
-	payload, err := json.Marshal(map[string]interface{}{
-		"variables": map[string]interface{}{
-			"search": map[string]interface{}{
-				"allowAdult":   false,
-				"allowUnknown": false,
-				"query":        title,
-			},
-			"limit":           40,
-			"page":            1,
-			"translationType": "sub",
-			"countryOrigin":   "ALL",
+	body, err := p.postQuery(ctx, searchQuery, map[string]interface{}{
+		"search": map[string]interface{}{
+			"allowAdult":   false,
+			"allowUnknown": false,
+			"query":        query,
 		},
-		"query": searchQuery,
+		"limit":           40,
+		"page":            1,
+		"translationType": translationType,
+		"countryOrigin":   "ALL",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResp struct {
+		Data struct {
+			Shows struct {
+				Edges []allAnimeShow `json:"edges"`
+			} `json:"shows"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &searchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return searchResp.Data.Shows.Edges, nil
+}
+
+// showAvailability fetches how many sub and dub episodes a show has
+func (p *AllAnimeProvider) showAvailability(ctx context.Context, showID string) (*Availability, error) {
+	showQuery := `query($showId: String!) { show(_id: $showId) { availableEpisodes } }`
+
+	body, err := p.postQuery(ctx, showQuery, map[string]interface{}{
+		"showId": showID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var showResp struct {
+		Data struct {
+			Show allAnimeShow `json:"show"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &showResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return showResp.Data.Show.availability(), nil
+}
+
+// postQuery sends a GraphQL query to allanime — POST with JSON body (matching jerry.sh)
+func (p *AllAnimeProvider) postQuery(ctx context.Context, query string, variables map[string]interface{}) ([]byte, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"variables": variables,
+		"query":     query,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -113,55 +191,36 @@ func (p *AllAnimeProvider) GetEpisodeInfo(ctx context.Context, mediaID int, epis
 		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body[:min(200, len(body))]))
 	}
 
-	var searchResp struct {
-		Data struct {
-			Shows struct {
-				Edges []struct {
-					ID                string `json:"_id"`
-					Name              string `json:"name"`
-					AvailableEpisodes struct {
-						Sub int `json:"sub"`
-						Dub int `json:"dub"`
-					} `json:"availableEpisodes"`
-				} `json:"edges"`
-			} `json:"shows"`
-		} `json:"data"`
-	}
+	return body, nil
+}
 
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response (status %d): %w", resp.StatusCode, err)
+// Search finds shows on allanime directly, without going through AniList
+func (p *AllAnimeProvider) Search(ctx context.Context, query string, subOrDub string) ([]SearchResult, error) {
+	if subOrDub == "" {
+		subOrDub = "sub"
 	}
 
-	if len(searchResp.Data.Shows.Edges) == 0 {
-		return nil, fmt.Errorf("no results found for: %s", title)
+	shows, err := p.searchShows(ctx, query, subOrDub)
+	if err != nil {
+		return nil, err
 	}
 
-	// Find best matching show — allanime's ranking doesn't always put the exact match first.
-	// Normalize both strings (lowercase, strip non-alphanumeric) and look for an exact match,
-	// then fall back to the result with the most sub episodes (main series has more eps than specials).
-	normalize := func(s string) string {
-		s = strings.ToLower(s)
-		re := regexp.MustCompile(`[^a-z0-9 ]+`)
-		s = re.ReplaceAllString(s, " ")
-		return strings.Join(strings.Fields(s), " ")
-	}
-	titleNorm := normalize(title)
-	show := searchResp.Data.Shows.Edges[0]
-	for _, edge := range searchResp.Data.Shows.Edges {
-		if normalize(edge.Name) == titleNorm {
-			show = edge
-			break
+	results := make([]SearchResult, 0, len(shows))
+	for _, show := range shows {
+		episodes := show.AvailableEpisodes.Sub
+		if subOrDub == "dub" {
+			episodes = show.AvailableEpisodes.Dub
 		}
+		results = append(results, SearchResult{
+			MediaID:      LocalMediaID(p.Name(), show.ID),
+			ShowID:       show.ID,
+			Title:        show.Name,
+			Episodes:     episodes,
+			Availability: show.availability(),
+		})
 	}
 
-	// Save to cache
-	SaveProviderMapping("allanime", mediaID, show.ID, title)
-
-	return &EpisodeInfo{
-		EpisodeID:    fmt.Sprintf("%d", episodeNum),
-		EpisodeTitle: fmt.Sprintf("Episode %d", episodeNum),
-		ShowID:       show.ID,
-	}, nil
+	return results, nil
 }
 
 // GetVideoLink extracts video links from allanime
@@ -176,12 +235,13 @@ func (p *AllAnimeProvider) GetVideoLink(ctx context.Context, episodeInfo *Episod
 		return nil, fmt.Errorf("failed to extract links: %w", err)
 	}
 	if len(links) == 0 {
-		return nil, fmt.Errorf("no video links found")
+		return nil, ErrNoVideoLinks
 	}
 
 	return &VideoData{
-		VideoURL: p.selectQuality(links, quality),
-		Referer:  allAnimeRefr,
+		VideoURL:  selectVariant(links, quality),
+		Referer:   allAnimeRefr,
+		Qualities: links,
 	}, nil
 }
 
@@ -399,7 +459,7 @@ func (p *AllAnimeProvider) extractLinks(ctx context.Context, sourceURLs json.Raw
 		return map[string]string{"best": url}, nil
 	}
 
-	return nil, fmt.Errorf("no video links found: all sources failed")
+	return nil, fmt.Errorf("%w: all sources failed", ErrNoVideoLinks)
 }
 
 // extractLinksLegacy is a fallback that uses string manipulation for backward compatibility
@@ -460,7 +520,7 @@ func (p *AllAnimeProvider) extractLinksLegacy(ctx context.Context, sourceURLs js
 	}
 
 	if len(allLinks) == 0 {
-		return nil, fmt.Errorf("no video links found: all providers failed (legacy parser)")
+		return nil, fmt.Errorf("%w: all providers failed (legacy parser)", ErrNoVideoLinks)
 	}
 
 	return allLinks, nil
@@ -760,30 +820,4 @@ func (p *AllAnimeProvider) getLinksFromProvider(ctx context.Context, providerURL
 	return links, nil
 }
 
-// selectQuality selects the best quality link
-func (p *AllAnimeProvider) selectQuality(links map[string]string, preferredQuality string) string {
-	// Try preferred quality
-	if link, ok := links[preferredQuality]; ok {
-		return link
-	}
-
-	// Sort qualities in descending order
-	qualities := make([]string, 0, len(links))
-	for q := range links {
-		qualities = append(qualities, q)
-	}
-
-	sort.Slice(qualities, func(i, j int) bool {
-		qi, _ := strconv.Atoi(qualities[i])
-		qj, _ := strconv.Atoi(qualities[j])
-		return qi > qj
-	})
-
-	// Return best quality
-	if len(qualities) > 0 {
-		return links[qualities[0]]
-	}
-
-	return ""
-}
 
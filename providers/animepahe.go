@@ -0,0 +1,284 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	animePaheBaseURL = "https://animepahe.ru"
+	kwikReferer      = "https://kwik.si/"
+)
+
+// AnimePaheProvider implements the animepahe provider
+type AnimePaheProvider struct {
+	client *http.Client
+}
+
+// NewAnimePaheProvider creates a new AnimePahe provider
+func NewAnimePaheProvider() *AnimePaheProvider {
+	return &AnimePaheProvider{
+		client: newHTTPClient("animepahe"),
+	}
+}
+
+// Name returns the provider name
+func (p *AnimePaheProvider) Name() string {
+	return "animepahe"
+}
+
+// get performs a GET request with the headers animepahe expects
+func (p *AnimePaheProvider) get(ctx context.Context, reqURL, referer string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// DDoS-Guard lets requests through with an empty cookie
+	req.Header.Set("Cookie", "__ddg2_=")
+	if referer != "" {
+		req.Header.Set("Referer", referer)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, nil
+}
+
+// GetEpisodeInfo fetches episode information from animepahe
+func (p *AnimePaheProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	title := primaryTitle(titles)
+
+	var animeSession string
+
+	// Check cache first
+	cached, err := LoadProviderMapping("animepahe", mediaID)
+	if err == nil && cached != nil {
+		animeSession = cached.ProviderID
+	} else {
+		animeSession, err = p.searchSession(ctx, titles)
+		if err != nil {
+			return nil, err
+		}
+		// Save to cache
+		SaveProviderMapping("animepahe", mediaID, animeSession, title)
+	}
+
+	type release struct {
+		Episode json.Number `json:"episode"`
+		Session string      `json:"session"`
+		Title   string      `json:"title"`
+	}
+	type releasePage struct {
+		PerPage  int       `json:"per_page"`
+		LastPage int       `json:"last_page"`
+		Data     []release `json:"data"`
+	}
+
+	fetchPage := func(page int) (*releasePage, error) {
+		body, err := p.get(ctx, fmt.Sprintf("%s/api?m=release&id=%s&sort=episode_asc&page=%d", animePaheBaseURL, animeSession, page), animePaheBaseURL)
+		if err != nil {
+			return nil, err
+		}
+		var result releasePage
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal episode list: %w", err)
+		}
+		return &result, nil
+	}
+
+	firstPage, err := fetchPage(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(firstPage.Data) == 0 {
+		return nil, fmt.Errorf("%w on animepahe: no episodes listed", ErrEpisodeNotFound)
+	}
+
+	// Later seasons continue numbering from the previous season, so offset by the first episode
+	firstEpisode, _ := strconv.ParseFloat(firstPage.Data[0].Episode.String(), 64)
+	episodeNum, err := strconv.ParseFloat(episode, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid episode %q: %w", episode, err)
+	}
+	target := episodeNum
+	if firstEpisode > 1 {
+		target = firstEpisode + episodeNum - 1
+	}
+
+	page := firstPage
+	if firstPage.PerPage > 0 {
+		pageNum := (int(episodeNum)-1)/firstPage.PerPage + 1
+		if pageNum > 1 && pageNum <= firstPage.LastPage {
+			page, err = fetchPage(pageNum)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, ep := range page.Data {
+		num, err := strconv.ParseFloat(ep.Episode.String(), 64)
+		if err != nil || num != target {
+			continue
+		}
+
+		epTitle := ep.Title
+		if epTitle == "" {
+			epTitle = "Episode " + episode
+		}
+
+		return &EpisodeInfo{
+			EpisodeID:    ep.Session,
+			EpisodeTitle: epTitle,
+			ShowID:       animeSession,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrEpisodeNotFound, episode)
+}
+
+// searchSession searches animepahe and returns the session of the best match
+func (p *AnimePaheProvider) searchSession(ctx context.Context, titles []string) (string, error) {
+	title := primaryTitle(titles)
+	body, err := p.get(ctx, fmt.Sprintf("%s/api?m=search&q=%s", animePaheBaseURL, url.QueryEscape(title)), animePaheBaseURL)
+	if err != nil {
+		return "", err
+	}
+
+	var results struct {
+		Data []struct {
+			Title   string `json:"title"`
+			Session string `json:"session"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &results); err != nil {
+		return "", fmt.Errorf("failed to unmarshal search results: %w", err)
+	}
+
+	if len(results.Data) == 0 {
+		return "", fmt.Errorf("%w on animepahe", ErrShowNotFound)
+	}
+
+	candidates := make([]searchResult, len(results.Data))
+	for i, result := range results.Data {
+		candidates[i] = searchResult{Title: result.Title, ID: result.Session}
+	}
+
+	return pickSearchResult(candidates, titles).ID, nil
+}
+
+// GetVideoLink extracts video links from animepahe
+func (p *AnimePaheProvider) GetVideoLink(ctx context.Context, episodeInfo *EpisodeInfo, quality string, subOrDub string) (*VideoData, error) {
+	playURL := fmt.Sprintf("%s/play/%s/%s", animePaheBaseURL, episodeInfo.ShowID, episodeInfo.EpisodeID)
+
+	body, err := p.get(ctx, playURL, animePaheBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each source is a button with the kwik embed, resolution and audio language
+	reButton := regexp.MustCompile(`data-src="([^"]*kwik[^"]*)"[^>]*data-resolution="(\d+)"[^>]*data-audio="([^"]*)"`)
+	matches := reButton.FindAllStringSubmatch(string(body), -1)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no kwik sources found")
+	}
+
+	audio := "jpn"
+	if subOrDub == "dub" {
+		audio = "eng"
+	}
+
+	links := make(map[string]string)
+	for _, m := range matches {
+		if m[3] == audio {
+			links[m[2]] = m[1]
+		}
+	}
+	if len(links) == 0 {
+		// Requested audio not available, fall back to whatever exists
+		for _, m := range matches {
+			links[m[2]] = m[1]
+		}
+	}
+
+	kwikURL := selectVariant(links, quality)
+
+	// Fetch kwik embed and unpack the obfuscated player script
+	body, err = p.get(ctx, kwikURL, animePaheBaseURL+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := unpackJS(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack kwik script: %w", err)
+	}
+
+	reSource := regexp.MustCompile(`source\s*=\s*\\?'([^'\\]*)`)
+	matchesSource := reSource.FindStringSubmatch(unpacked)
+	if len(matchesSource) < 2 {
+		return nil, ErrNoVideoLinks
+	}
+
+	return &VideoData{
+		VideoURL: matchesSource[1],
+		Referer:  kwikReferer,
+	}, nil
+}
+
+// unpackJS decodes Dean Edwards' p.a.c.k.e.r. obfuscated JavaScript
+func unpackJS(source string) (string, error) {
+	re := regexp.MustCompile(`}\('(.*)',\s*(\d+),\s*(\d+),\s*'(.*?)'\.split\('\|'\)`)
+	m := re.FindStringSubmatch(source)
+	if len(m) < 5 {
+		return "", fmt.Errorf("packed script not found")
+	}
+
+	payload := m[1]
+	radix, err := strconv.Atoi(m[2])
+	if err != nil {
+		return "", fmt.Errorf("invalid radix: %w", err)
+	}
+	symbols := strings.Split(m[4], "|")
+
+	const alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	decode := func(word string) int {
+		n := 0
+		for _, c := range word {
+			idx := strings.IndexRune(alphabet, c)
+			if idx < 0 || idx >= radix {
+				return -1
+			}
+			n = n*radix + idx
+		}
+		return n
+	}
+
+	reWord := regexp.MustCompile(`\b\w+\b`)
+	unpacked := reWord.ReplaceAllStringFunc(payload, func(word string) string {
+		idx := decode(word)
+		if idx >= 0 && idx < len(symbols) && symbols[idx] != "" {
+			return symbols[idx]
+		}
+		return word
+	})
+
+	return unpacked, nil
+}
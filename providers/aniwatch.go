@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
-	"time"
+
+	"github.com/pranshuj73/oni/logger"
 )
 
 // AniWatchProvider implements the aniwatch provider
@@ -18,19 +22,8 @@ type AniWatchProvider struct {
 
 // NewAniWatchProvider creates a new AniWatch provider
 func NewAniWatchProvider() *AniWatchProvider {
-	// Configure HTTP client with timeout and connection pooling
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-	}
-
 	return &AniWatchProvider{
-		client: &http.Client{
-			Timeout:   60 * time.Second,
-			Transport: transport,
-		},
+		client: newHTTPClient("aniwatch"),
 	}
 }
 
@@ -57,11 +50,127 @@ func hiAnimeLines(body []byte) []string {
 }
 
 // GetEpisodeInfo fetches episode information from aniwatch
-func (p *AniWatchProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error) {
-	// Fetch aniwatch ID from mal-backup
-	backupURL := fmt.Sprintf("https://raw.githubusercontent.com/bal-mackup/mal-backup/master/anilist/anime/%d.json", mediaID)
+func (p *AniWatchProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	lines, err := p.episodeListLines(ctx, mediaID, titles)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse episode list: split on "<" (jerry.sh approach) then match per line.
+	// Each episode anchor looks like: a title="Ep Title" ... data-number="N" ... data-id="12345"
+	reEpLine := regexp.MustCompile(`a\s[^>]*title="([^"]*)"[^>]*data-id="(\d+)"`)
+	reDataNum := regexp.MustCompile(`data-number="([\d.]+)"`)
+
+	var episodeID, episodeTitle string
+	for _, line := range lines {
+		m := reEpLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		// Prefer data-number attribute for matching the episode
+		numMatch := reDataNum.FindStringSubmatch(line)
+		if numMatch != nil {
+			if numMatch[1] == episode {
+				episodeTitle = m[1]
+				episodeID = m[2]
+				break
+			}
+		}
+	}
+
+	// Fallback: take the Nth anchor (1-indexed) if data-number wasn't found; specials have no position
+	if episodeNum, err := strconv.Atoi(episode); err == nil && episodeID == "" {
+		count := 0
+		for _, line := range lines {
+			if m := reEpLine.FindStringSubmatch(line); m != nil {
+				count++
+				if count == episodeNum {
+					episodeTitle = m[1]
+					episodeID = m[2]
+					break
+				}
+			}
+		}
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", backupURL, nil)
+	if episodeID == "" {
+		return nil, fmt.Errorf("%w: %s", ErrEpisodeNotFound, episode)
+	}
+
+	return &EpisodeInfo{
+		EpisodeID:    episodeID,
+		EpisodeTitle: html.UnescapeString(episodeTitle),
+	}, nil
+}
+
+// ListEpisodes returns every episode hianime lists for the show, with titles
+func (p *AniWatchProvider) ListEpisodes(ctx context.Context, mediaID int, titles []string) ([]Episode, error) {
+	lines, err := p.episodeListLines(ctx, mediaID, titles)
+	if err != nil {
+		return nil, err
+	}
+
+	reEpLine := regexp.MustCompile(`a\s[^>]*title="([^"]*)"[^>]*data-id="(\d+)"`)
+	reDataNum := regexp.MustCompile(`data-number="([\d.]+)"`)
+
+	var episodes []Episode
+	for _, line := range lines {
+		m := reEpLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		number := len(episodes) + 1
+		if numMatch := reDataNum.FindStringSubmatch(line); numMatch != nil {
+			// Specials like "6.5" have no whole episode number, so they stay out of the list
+			n, err := strconv.Atoi(numMatch[1])
+			if err != nil {
+				continue
+			}
+			number = n
+		}
+		episodes = append(episodes, Episode{Number: number, Title: html.UnescapeString(m[1])})
+	}
+
+	if len(episodes) == 0 {
+		return nil, fmt.Errorf("%w on aniwatch", ErrEpisodeNotFound)
+	}
+
+	return episodes, nil
+}
+
+// episodeListLines resolves the hianime show and fetches its episode list, split one tag per line
+func (p *AniWatchProvider) episodeListLines(ctx context.Context, mediaID int, titles []string) ([]string, error) {
+	title := primaryTitle(titles)
+
+	// Check cache first
+	var aniwatchID string
+	cached, err := LoadProviderMapping("aniwatch", mediaID)
+	if err == nil && cached != nil {
+		aniwatchID = cached.ProviderID
+	}
+
+	if aniwatchID == "" {
+		aniwatchID, err = p.lookupBackupID(ctx, mediaID)
+		if err != nil {
+			// Newer shows are often missing from mal-backup, search aniwatch directly
+			logger.Warn("mal-backup lookup failed, searching aniwatch by title", map[string]interface{}{
+				"mediaID": mediaID,
+				"title":   title,
+				"error":   err.Error(),
+			})
+			aniwatchID, err = p.searchID(ctx, titles)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Save to cache
+		SaveProviderMapping("aniwatch", mediaID, aniwatchID, title)
+	}
+
+	// Fetch episode list
+	req, err := http.NewRequestWithContext(ctx, "GET",
+		fmt.Sprintf("https://hianime.to/ajax/v2/episode/list/%s", aniwatchID), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -77,6 +186,17 @@ func (p *AniWatchProvider) GetEpisodeInfo(ctx context.Context, mediaID int, epis
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	return hiAnimeLines(body), nil
+}
+
+// lookupBackupID resolves the hianime show ID from the mal-backup mapping
+func (p *AniWatchProvider) lookupBackupID(ctx context.Context, mediaID int) (string, error) {
+	// Fetch aniwatch ID from mal-backup
+	body, err := fetchBackup(ctx, p.client, mediaID, "aniwatch")
+	if err != nil {
+		return "", err
+	}
+
 	// Parse the backup JSON and extract the hianime show ID from known site keys.
 	// The URL format is "https://<domain>/<slug>-<id>" — we want the trailing numeric ID.
 	var backup struct {
@@ -85,7 +205,7 @@ func (p *AniWatchProvider) GetEpisodeInfo(ctx context.Context, mediaID int, epis
 		} `json:"Sites"`
 	}
 	if err := json.Unmarshal(body, &backup); err != nil {
-		return nil, fmt.Errorf("failed to parse backup JSON: %w", err)
+		return "", fmt.Errorf("failed to parse backup JSON: %w", err)
 	}
 
 	var aniwatchID string
@@ -107,72 +227,45 @@ func (p *AniWatchProvider) GetEpisodeInfo(ctx context.Context, mediaID int, epis
 	}
 
 	if aniwatchID == "" {
-		return nil, fmt.Errorf("aniwatch ID not found for media ID %d", mediaID)
+		return "", noMappingError("aniwatch")
 	}
 
-	// Fetch episode list
-	req, err = http.NewRequestWithContext(ctx, "GET",
-		fmt.Sprintf("https://hianime.to/ajax/v2/episode/list/%s", aniwatchID), nil)
+	return aniwatchID, nil
+}
+
+// searchID searches hianime by title and returns the show ID of the best match
+func (p *AniWatchProvider) searchID(ctx context.Context, titles []string) (string, error) {
+	title := primaryTitle(titles)
+	req, err := http.NewRequestWithContext(ctx, "GET",
+		fmt.Sprintf("https://hianime.to/search?keyword=%s", url.QueryEscape(title)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err = p.client.Do(req)
+	resp, err := p.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return "", fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err = io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return "", fmt.Errorf("failed to read response: %w", err)
 	}
 
-	// Parse episode list: split on "<" (jerry.sh approach) then match per line.
-	// Each episode anchor looks like: a title="Ep Title" ... data-number="N" ... data-id="12345"
-	reEpLine := regexp.MustCompile(`a\s[^>]*title="([^"]*)"[^>]*data-id="(\d+)"`)
-	reDataNum := regexp.MustCompile(`data-number="(\d+)"`)
-
-	var episodeID, episodeTitle string
-	for _, line := range hiAnimeLines(body) {
-		m := reEpLine.FindStringSubmatch(line)
-		if m == nil {
-			continue
-		}
-		// Prefer data-number attribute for matching the episode
-		numMatch := reDataNum.FindStringSubmatch(line)
-		if numMatch != nil {
-			if numMatch[1] == fmt.Sprintf("%d", episodeNum) {
-				episodeTitle = m[1]
-				episodeID = m[2]
-				break
-			}
-		}
-	}
-
-	// Fallback: take the Nth anchor (1-indexed) if data-number wasn't found
-	if episodeID == "" {
-		count := 0
-		for _, line := range hiAnimeLines(body) {
-			if m := reEpLine.FindStringSubmatch(line); m != nil {
-				count++
-				if count == episodeNum {
-					episodeTitle = m[1]
-					episodeID = m[2]
-					break
-				}
-			}
-		}
+	// Result anchors look like: a href="/<slug>-<id>?ref=search" title="<Title>"
+	reResult := regexp.MustCompile(`href="/[^"?]*-(\d+)\?ref=search"[^>]*title="([^"]*)"`)
+	var results []searchResult
+	for _, m := range reResult.FindAllStringSubmatch(string(body), -1) {
+		results = append(results, searchResult{Title: html.UnescapeString(m[2]), ID: m[1]})
 	}
 
-	if episodeID == "" {
-		return nil, fmt.Errorf("episode %d not found", episodeNum)
+	best := pickSearchResult(results, titles)
+	if best == nil {
+		return "", fmt.Errorf("%w on aniwatch for %q", ErrShowNotFound, title)
 	}
 
-	return &EpisodeInfo{
-		EpisodeID:    episodeID,
-		EpisodeTitle: episodeTitle,
-	}, nil
+	return best.ID, nil
 }
 
 // GetVideoLink extracts video links from aniwatch
@@ -273,12 +366,25 @@ func (p *AniWatchProvider) GetVideoLink(ctx context.Context, episodeInfo *Episod
 	reVideo := regexp.MustCompile(`"file"\s*:\s*"([^"]*\.m3u8)"`)
 	matchesVideo := reVideo.FindStringSubmatch(string(body))
 	if len(matchesVideo) < 2 {
-		return nil, fmt.Errorf("video link not found")
+		return nil, ErrNoVideoLinks
 	}
 
 	videoURL := strings.ReplaceAll(matchesVideo[1], `\/`, `/`)
+
+	// Pick the requested variant from the master playlist; without a quality, or when the
+	// playlist has no variants, keep the master so the player adapts on its own
+	var variants map[string]string
 	if quality != "" {
-		videoURL = strings.Replace(videoURL, "/playlist.m3u8", fmt.Sprintf("/%s/index.m3u8", quality), 1)
+		v, err := fetchVariants(ctx, p.client, videoURL, "")
+		if err != nil {
+			logger.Debug("Failed to read AniWatch playlist variants", map[string]interface{}{
+				"url":   videoURL,
+				"error": err.Error(),
+			})
+		} else if len(v) > 0 {
+			variants = v
+			videoURL = selectVariant(variants, quality)
+		}
 	}
 
 	// Extract subtitles
@@ -293,5 +399,6 @@ func (p *AniWatchProvider) GetVideoLink(ctx context.Context, episodeInfo *Episod
 	return &VideoData{
 		VideoURL:     videoURL,
 		SubtitleURLs: subtitles,
+		Qualities:    variants,
 	}, nil
 }
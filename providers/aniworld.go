@@ -9,7 +9,8 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
-	"time"
+
+	"github.com/pranshuj73/oni/logger"
 )
 
 // AniWorldProvider implements the aniworld provider
@@ -19,19 +20,8 @@ type AniWorldProvider struct {
 
 // NewAniWorldProvider creates a new AniWorld provider
 func NewAniWorldProvider() *AniWorldProvider {
-	// Configure HTTP client with timeout and connection pooling
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-	}
-
 	return &AniWorldProvider{
-		client: &http.Client{
-			Timeout:   60 * time.Second,
-			Transport: transport,
-		},
+		client: newHTTPClient("aniworld"),
 	}
 }
 
@@ -41,36 +31,8 @@ func (p *AniWorldProvider) Name() string {
 }
 
 // GetEpisodeInfo fetches episode information from aniworld
-func (p *AniWorldProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error) {
-	// Fetch title from mal-backup
-	backupURL := fmt.Sprintf("https://raw.githubusercontent.com/bal-mackup/mal-backup/master/anilist/anime/%d.json", mediaID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", backupURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := p.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Extract title
-	reTitle := regexp.MustCompile(`"title":\s*"([^"]*)"`)
-	matchesTitle := reTitle.FindStringSubmatch(string(body))
-
-	if len(matchesTitle) < 2 {
-		return nil, fmt.Errorf("title not found in backup")
-	}
-
-	// Properly escape the title for URL use
-	searchTitle := url.QueryEscape(matchesTitle[1])
+func (p *AniWorldProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	title := primaryTitle(titles)
 
 	// Check cache first
 	cached, err := LoadProviderMapping("aniworld", mediaID)
@@ -78,29 +40,43 @@ func (p *AniWorldProvider) GetEpisodeInfo(ctx context.Context, mediaID int, epis
 		// Use cached provider ID (anime link)
 		return &EpisodeInfo{
 			EpisodeID:    cached.ProviderID,
-			EpisodeTitle: fmt.Sprintf("Episode %d", episodeNum),
+			EpisodeTitle: "Episode " + episode,
 		}, nil
 	}
 
+	// Prefer the mal-backup title, falling back to the AniList title for shows missing from it
+	backupTitle, err := fetchBackupTitle(ctx, p.client, mediaID, "aniworld")
+	if err != nil {
+		logger.Warn("mal-backup lookup failed, searching aniworld by AniList title", map[string]interface{}{
+			"mediaID": mediaID,
+			"title":   title,
+			"error":   err.Error(),
+		})
+		backupTitle = title
+	}
+
+	// Properly escape the title for URL use
+	searchTitle := url.QueryEscape(backupTitle)
+
 	// Search on aniworld
 	searchURL := "https://aniworld.to/ajax/search"
 
 	data := fmt.Sprintf("keyword=%s", searchTitle)
 
-	req, err = http.NewRequestWithContext(ctx, "POST", searchURL, strings.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, "POST", searchURL, strings.NewReader(data))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err = p.client.Do(req)
+	resp, err := p.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err = io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
@@ -130,18 +106,22 @@ func (p *AniWorldProvider) GetEpisodeInfo(ctx context.Context, mediaID int, epis
 	}
 
 	if len(results) == 0 {
-		return nil, fmt.Errorf("no results found on aniworld")
+		return nil, fmt.Errorf("%w on aniworld", ErrShowNotFound)
 	}
 
-	// Use first result
-	animeLink := results[0].Link
+	// Pick the closest title, also matching against the mal-backup title we searched with
+	candidates := make([]searchResult, len(results))
+	for i, result := range results {
+		candidates[i] = searchResult{Title: result.Title, ID: result.Link}
+	}
+	animeLink := pickSearchResult(candidates, append([]string{backupTitle}, titles...)).ID
 
 	// Save to cache
 	SaveProviderMapping("aniworld", mediaID, animeLink, title)
 
 	return &EpisodeInfo{
 		EpisodeID:    animeLink,
-		EpisodeTitle: fmt.Sprintf("Episode %d", episodeNum),
+		EpisodeTitle: "Episode " + episode,
 	}, nil
 }
 
@@ -171,7 +151,7 @@ func (p *AniWorldProvider) GetVideoLink(ctx context.Context, episodeInfo *Episod
 	matchesEp := reEp.FindStringSubmatch(string(body))
 
 	if len(matchesEp) < 2 {
-		return nil, fmt.Errorf("episode not found")
+		return nil, ErrEpisodeNotFound
 	}
 
 	episodeHref := matchesEp[1]
@@ -232,7 +212,7 @@ func (p *AniWorldProvider) GetVideoLink(ctx context.Context, episodeInfo *Episod
 	matchesM3u8 := reM3u8.FindStringSubmatch(string(body))
 
 	if len(matchesM3u8) < 2 {
-		return nil, fmt.Errorf("video link not found")
+		return nil, ErrNoVideoLinks
 	}
 
 	return &VideoData{
@@ -0,0 +1,67 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+)
+
+// ErrNoMapping is returned when mal-backup has no entry for an anime on a provider
+var ErrNoMapping = errors.New("no mapping found")
+
+// noMappingError builds an ErrNoMapping error naming the provider
+func noMappingError(provider string) error {
+	return fmt.Errorf("%w for this anime on %s", ErrNoMapping, provider)
+}
+
+// fetchBackup fetches the mal-backup JSON for an AniList ID
+// A missing file (GitHub 404 page) is reported as ErrNoMapping instead of being parsed
+func fetchBackup(ctx context.Context, client *http.Client, mediaID int, provider string) ([]byte, error) {
+	backupURL := fmt.Sprintf("https://raw.githubusercontent.com/bal-mackup/mal-backup/master/anilist/anime/%d.json", mediaID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", backupURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, noMappingError(provider)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("mal-backup returned HTTP %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, nil
+}
+
+// fetchBackupTitle fetches the canonical title for an AniList ID from mal-backup
+func fetchBackupTitle(ctx context.Context, client *http.Client, mediaID int, provider string) (string, error) {
+	body, err := fetchBackup(ctx, client, mediaID, provider)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract title
+	reTitle := regexp.MustCompile(`"title":\s*"([^"]*)"`)
+	matchesTitle := reTitle.FindStringSubmatch(string(body))
+
+	if len(matchesTitle) < 2 {
+		return "", noMappingError(provider)
+	}
+
+	return matchesTitle[1], nil
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/pranshuj73/oni/utils"
 	"gopkg.in/ini.v1"
 )
 
@@ -22,15 +24,16 @@ var cacheInitialized bool
 
 // getCachePath returns the path to the provider cache file
 func getCachePath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	cacheDir, err := utils.CacheDir()
 	if err != nil {
 		return "", err
 	}
-	cacheDir := filepath.Join(homeDir, ".oni")
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+	cachePath := filepath.Join(cacheDir, "provider_cache.ini")
+	// Older versions kept the provider cache directly in ~/.oni
+	if err := utils.MigrateLegacyFile(cachePath, "provider_cache.ini"); err != nil {
 		return "", err
 	}
-	return filepath.Join(cacheDir, "provider_cache.ini"), nil
+	return cachePath, nil
 }
 
 // initCache initializes the cache file
@@ -159,3 +162,119 @@ func ClearAllProviderMappings() error {
 	return os.Remove(cachePath)
 }
 
+
+// offsetSection returns the cache section holding episode offsets for a provider
+func offsetSection(provider string) string {
+	return provider + "_offsets"
+}
+
+// LoadEpisodeOffset loads the episode offset for an anime on a provider
+// An offset of 12 means AniList episode 1 is episode 13 on the provider
+func LoadEpisodeOffset(provider string, mediaID int) int {
+	if err := initCache(); err != nil {
+		return 0
+	}
+
+	section, err := cacheFile.GetSection(offsetSection(provider))
+	if err != nil {
+		// Section doesn't exist
+		return 0
+	}
+
+	offset, err := section.Key(fmt.Sprintf("%d", mediaID)).Int()
+	if err != nil {
+		return 0
+	}
+	return offset
+}
+
+// SaveEpisodeOffset saves the episode offset for an anime on a provider
+// An offset of 0 removes the entry
+func SaveEpisodeOffset(provider string, mediaID int, offset int) error {
+	if err := initCache(); err != nil {
+		return err
+	}
+
+	name := offsetSection(provider)
+	key := fmt.Sprintf("%d", mediaID)
+
+	if offset == 0 {
+		if section, err := cacheFile.GetSection(name); err == nil {
+			section.DeleteKey(key)
+		}
+	} else {
+		section, err := cacheFile.GetSection(name)
+		if err != nil {
+			// Section doesn't exist, create it
+			section, err = cacheFile.NewSection(name)
+			if err != nil {
+				return fmt.Errorf("failed to create section: %w", err)
+			}
+		}
+		section.Key(key).SetValue(fmt.Sprintf("%d", offset))
+	}
+
+	cachePath, err := getCachePath()
+	if err != nil {
+		return err
+	}
+
+	return cacheFile.SaveTo(cachePath)
+}
+
+// OffsetEpisode shifts an episode number such as "6" or "6.5" by a saved offset
+func OffsetEpisode(episode string, offset int) string {
+	if offset == 0 {
+		return episode
+	}
+	num, err := strconv.ParseFloat(episode, 64)
+	if err != nil {
+		return episode
+	}
+	return strconv.FormatFloat(num+float64(offset), 'f', -1, 64)
+}
+
+// translationSection returns the cache section holding voiceover choices for a provider
+func translationSection(provider string) string {
+	return provider + "_translations"
+}
+
+// LoadTranslation loads the chosen voiceover/translation ID for an anime on a provider
+func LoadTranslation(provider string, mediaID int) string {
+	if err := initCache(); err != nil {
+		return ""
+	}
+
+	section, err := cacheFile.GetSection(translationSection(provider))
+	if err != nil {
+		// Section doesn't exist
+		return ""
+	}
+
+	return section.Key(fmt.Sprintf("%d", mediaID)).String()
+}
+
+// SaveTranslation saves the chosen voiceover/translation ID for an anime on a provider
+func SaveTranslation(provider string, mediaID int, translationID string) error {
+	if err := initCache(); err != nil {
+		return err
+	}
+
+	name := translationSection(provider)
+	section, err := cacheFile.GetSection(name)
+	if err != nil {
+		// Section doesn't exist, create it
+		section, err = cacheFile.NewSection(name)
+		if err != nil {
+			return fmt.Errorf("failed to create section: %w", err)
+		}
+	}
+	section.Key(fmt.Sprintf("%d", mediaID)).SetValue(translationID)
+
+	cachePath, err := getCachePath()
+	if err != nil {
+		return err
+	}
+
+	return cacheFile.SaveTo(cachePath)
+}
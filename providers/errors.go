@@ -0,0 +1,18 @@
+package providers
+
+import "errors"
+
+// Errors returned by providers, so callers can tell the user what to try next
+var (
+	// ErrShowNotFound is returned when a provider's search has no match for the anime
+	ErrShowNotFound = errors.New("no results found")
+
+	// ErrEpisodeNotFound is returned when the provider has the anime but not the episode
+	ErrEpisodeNotFound = errors.New("episode not found")
+
+	// ErrNoVideoLinks is returned when none of the provider's sources gave a playable link
+	ErrNoVideoLinks = errors.New("no video links found")
+
+	// ErrListingUnsupported is returned by providers that can't list a show's episodes
+	ErrListingUnsupported = errors.New("episode listing is not supported")
+)
@@ -0,0 +1,298 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	gogoBaseURL = "https://anitaku.pe"
+
+	// Keys used by the gogocdn encrypt-ajax endpoint
+	gogoKey       = "37911490979715163134003223491201"
+	gogoSecondKey = "54674138327930866480207815084989"
+	gogoIV        = "3134003223491201"
+)
+
+// GogoanimeProvider implements the gogoanime provider
+type GogoanimeProvider struct {
+	client *http.Client
+}
+
+// NewGogoanimeProvider creates a new Gogoanime provider
+func NewGogoanimeProvider() *GogoanimeProvider {
+	return &GogoanimeProvider{
+		client: newHTTPClient("gogoanime"),
+	}
+}
+
+// Name returns the provider name
+func (p *GogoanimeProvider) Name() string {
+	return "gogoanime"
+}
+
+// GetEpisodeInfo fetches episode information from gogoanime
+func (p *GogoanimeProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	title := primaryTitle(titles)
+
+	// Check cache first
+	cached, err := LoadProviderMapping("gogoanime", mediaID)
+	if err == nil && cached != nil {
+		return &EpisodeInfo{
+			EpisodeID:    strings.ReplaceAll(episode, ".", "-"),
+			EpisodeTitle: "Episode " + episode,
+			ShowID:       cached.ProviderID,
+		}, nil
+	}
+
+	// Fetch gogoanime slug from mal-backup
+	body, err := fetchBackup(ctx, p.client, mediaID, "gogoanime")
+	if err != nil {
+		return nil, err
+	}
+
+	var backup struct {
+		Sites map[string]map[string]struct {
+			Identifier string `json:"identifier"`
+		} `json:"Sites"`
+	}
+	if err := json.Unmarshal(body, &backup); err != nil {
+		return nil, fmt.Errorf("failed to parse backup JSON: %w", err)
+	}
+
+	// Prefer the sub slug; the dub slug is derived from it in GetVideoLink
+	var slug string
+	for identifier := range backup.Sites["Gogoanime"] {
+		if !strings.HasSuffix(identifier, "-dub") {
+			slug = identifier
+			break
+		}
+		slug = strings.TrimSuffix(identifier, "-dub")
+	}
+
+	if slug == "" {
+		return nil, noMappingError("gogoanime")
+	}
+
+	// Save to cache
+	SaveProviderMapping("gogoanime", mediaID, slug, title)
+
+	return &EpisodeInfo{
+		EpisodeID:    strings.ReplaceAll(episode, ".", "-"),
+		EpisodeTitle: "Episode " + episode,
+		ShowID:       slug,
+	}, nil
+}
+
+// GetVideoLink extracts video links from gogoanime
+func (p *GogoanimeProvider) GetVideoLink(ctx context.Context, episodeInfo *EpisodeInfo, quality string, subOrDub string) (*VideoData, error) {
+	slug := episodeInfo.ShowID
+	if subOrDub == "dub" {
+		slug += "-dub"
+	}
+
+	// Fetch episode page
+	episodeURL := fmt.Sprintf("%s/%s-episode-%s", gogoBaseURL, slug, episodeInfo.EpisodeID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", episodeURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w on gogoanime: %s", ErrEpisodeNotFound, episodeInfo.EpisodeID)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// Extract the gogocdn embed (streaming.php) link
+	reEmbed := regexp.MustCompile(`data-video="([^"]*streaming\.php[^"]*)"`)
+	matchesEmbed := reEmbed.FindStringSubmatch(string(body))
+	if len(matchesEmbed) < 2 {
+		return nil, fmt.Errorf("embed link not found")
+	}
+
+	embedURL := matchesEmbed[1]
+	if strings.HasPrefix(embedURL, "//") {
+		embedURL = "https:" + embedURL
+	}
+
+	parsedEmbed, err := url.Parse(embedURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid embed link: %w", err)
+	}
+	embedID := parsedEmbed.Query().Get("id")
+	if embedID == "" {
+		return nil, fmt.Errorf("embed ID not found")
+	}
+
+	// Fetch embed page to get the encrypted token
+	req, err = http.NewRequestWithContext(ctx, "GET", embedURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err = p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	reToken := regexp.MustCompile(`data-name="episode"\s+data-value="([^"]*)"`)
+	matchesToken := reToken.FindStringSubmatch(string(body))
+	if len(matchesToken) < 2 {
+		return nil, fmt.Errorf("encrypted token not found")
+	}
+
+	token, err := gogoDecrypt(matchesToken[1], gogoKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	encryptedID, err := gogoEncrypt(embedID, gogoKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt ID: %w", err)
+	}
+
+	// Request the encrypted sources
+	ajaxURL := fmt.Sprintf("%s://%s/encrypt-ajax.php?id=%s&alias=%s&%s",
+		parsedEmbed.Scheme, parsedEmbed.Host, url.QueryEscape(encryptedID), embedID, string(token))
+
+	req, err = http.NewRequestWithContext(ctx, "GET", ajaxURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Requested-With", "XMLHttpRequest")
+	req.Header.Set("Referer", embedURL)
+
+	resp, err = p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var ajaxResp struct {
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(body, &ajaxResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ajax response: %w", err)
+	}
+
+	decrypted, err := gogoDecrypt(ajaxResp.Data, gogoSecondKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt sources: %w", err)
+	}
+
+	var sources struct {
+		Source []struct {
+			File  string `json:"file"`
+			Label string `json:"label"`
+		} `json:"source"`
+		SourceBk []struct {
+			File  string `json:"file"`
+			Label string `json:"label"`
+		} `json:"source_bk"`
+	}
+	if err := json.Unmarshal(decrypted, &sources); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sources: %w", err)
+	}
+
+	var videoURL string
+	if len(sources.Source) > 0 {
+		videoURL = sources.Source[0].File
+	} else if len(sources.SourceBk) > 0 {
+		videoURL = sources.SourceBk[0].File
+	}
+
+	if videoURL == "" {
+		return nil, fmt.Errorf("%w: no video sources", ErrNoVideoLinks)
+	}
+
+	// Pick the requested variant from the master playlist if possible
+	var variants map[string]string
+	if strings.Contains(videoURL, ".m3u8") {
+		if v, err := fetchVariants(ctx, p.client, videoURL, embedURL); err == nil && len(v) > 0 {
+			variants = v
+			videoURL = selectVariant(variants, quality)
+		}
+	}
+
+	return &VideoData{
+		VideoURL:  videoURL,
+		Referer:   embedURL,
+		Qualities: variants,
+	}, nil
+}
+
+// gogoEncrypt encrypts plaintext with AES-CBC and returns it base64 encoded
+func gogoEncrypt(plaintext, key string) (string, error) {
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return "", err
+	}
+
+	// PKCS7 padding
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	padded := append([]byte(plaintext), bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, []byte(gogoIV)).CryptBlocks(ciphertext, padded)
+
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// gogoDecrypt decrypts base64 encoded AES-CBC ciphertext
+func gogoDecrypt(encoded, key string) ([]byte, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("invalid ciphertext length %d", len(ciphertext))
+	}
+
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, []byte(gogoIV)).CryptBlocks(plaintext, ciphertext)
+
+	// Strip PKCS7 padding
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > aes.BlockSize || padding > len(plaintext) {
+		return nil, fmt.Errorf("invalid padding")
+	}
+
+	return plaintext[:len(plaintext)-padding], nil
+}
@@ -5,13 +5,15 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
+
+	"github.com/pranshuj73/oni/logger"
 )
 
 // HDRezkaProvider implements the hdrezka provider
@@ -21,19 +23,8 @@ type HDRezkaProvider struct {
 
 // NewHDRezkaProvider creates a new HDRezka provider
 func NewHDRezkaProvider() *HDRezkaProvider {
-	// Configure HTTP client with timeout and connection pooling
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-	}
-
 	return &HDRezkaProvider{
-		client: &http.Client{
-			Timeout:   60 * time.Second,
-			Transport: transport,
-		},
+		client: newHTTPClient("hdrezka"),
 	}
 }
 
@@ -43,36 +34,8 @@ func (p *HDRezkaProvider) Name() string {
 }
 
 // GetEpisodeInfo fetches episode information from hdrezka
-func (p *HDRezkaProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error) {
-	// Fetch title from mal-backup
-	backupURL := fmt.Sprintf("https://raw.githubusercontent.com/bal-mackup/mal-backup/master/anilist/anime/%d.json", mediaID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", backupURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := p.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Extract title
-	reTitle := regexp.MustCompile(`"title":\s*"([^"]*)"`)
-	matchesTitle := reTitle.FindStringSubmatch(string(body))
-
-	if len(matchesTitle) < 2 {
-		return nil, fmt.Errorf("title not found in backup")
-	}
-
-	// Properly escape the title for URL use
-	searchTitle := url.QueryEscape(matchesTitle[1])
+func (p *HDRezkaProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	title := primaryTitle(titles)
 
 	// Check cache first
 	cached, err := LoadProviderMapping("hdrezka", mediaID)
@@ -81,41 +44,64 @@ func (p *HDRezkaProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episo
 		parts := strings.Split(cached.ProviderID, "|")
 		if len(parts) == 2 {
 			return &EpisodeInfo{
-				EpisodeID:    parts[1],
-				EpisodeTitle: fmt.Sprintf("Episode %d", episodeNum),
-				MediaType:    parts[0],
+				EpisodeID:     parts[1],
+				EpisodeTitle:  "Episode " + episode,
+				MediaType:     parts[0],
+				TranslationID: LoadTranslation("hdrezka", mediaID),
 			}, nil
 		}
 	}
 
+	// Prefer the mal-backup title, falling back to the AniList title for shows missing from it
+	backupTitle, err := fetchBackupTitle(ctx, p.client, mediaID, "hdrezka")
+	if err != nil {
+		logger.Warn("mal-backup lookup failed, searching hdrezka by AniList title", map[string]interface{}{
+			"mediaID": mediaID,
+			"title":   title,
+			"error":   err.Error(),
+		})
+		backupTitle = title
+	}
+
+	// Properly escape the title for URL use
+	searchTitle := url.QueryEscape(backupTitle)
+
 	// Search on hdrezka
 	searchURL := fmt.Sprintf("https://hdrezka.website/search/?do=search&subaction=search&q=%s", searchTitle)
 
-	req, err = http.NewRequestWithContext(ctx, "GET", searchURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("User-Agent", "Mozilla/5.0")
 
-	resp, err = p.client.Do(req)
+	resp, err := p.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err = io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	// Parse search results
 	reResult := regexp.MustCompile(`src="([^"]*)".*?<a href="https://hdrezka\.website/(.*)/(.*)/(.*)\.html">([^<]*)</a>.*?<div>([0-9]*)`)
-	matchesResult := reResult.FindStringSubmatch(string(body))
+	allMatches := reResult.FindAllStringSubmatch(string(body), -1)
+
+	if len(allMatches) == 0 {
+		return nil, fmt.Errorf("%w on hdrezka", ErrShowNotFound)
+	}
 
-	if len(matchesResult) < 7 {
-		return nil, fmt.Errorf("no results found on hdrezka")
+	// Pick the closest title, also matching against the mal-backup title we searched with
+	candidates := make([]searchResult, len(allMatches))
+	for i, m := range allMatches {
+		candidates[i] = searchResult{Title: m[5], ID: strconv.Itoa(i)}
 	}
+	best, _ := strconv.Atoi(pickSearchResult(candidates, append([]string{backupTitle}, titles...)).ID)
+	matchesResult := allMatches[best]
 
 	mediaType := matchesResult[2]
 	episodeID := fmt.Sprintf("%s/%s", matchesResult[3], matchesResult[4])
@@ -125,9 +111,10 @@ func (p *HDRezkaProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episo
 	SaveProviderMapping("hdrezka", mediaID, cacheValue, title)
 
 	return &EpisodeInfo{
-		EpisodeID:    episodeID,
-		EpisodeTitle: fmt.Sprintf("Episode %d", episodeNum),
-		MediaType:    mediaType,
+		EpisodeID:     episodeID,
+		EpisodeTitle:  "Episode " + episode,
+		MediaType:     mediaType,
+		TranslationID: LoadTranslation("hdrezka", mediaID),
 	}, nil
 }
 
@@ -211,10 +198,19 @@ func (p *HDRezkaProvider) GetVideoLink(ctx context.Context, episodeInfo *Episode
 		}
 	}
 	
+	// Extract available translations; titles with a single voiceover have no list
+	translations := parseHDRezkaTranslations(bodyStr, defaultTranslatorID)
+
+	// Use the saved voiceover if this title still offers it
 	translatorID := defaultTranslatorID
-	
-	// Extract available translations (optional - for now use default)
-	// This would allow user to select translation, but for now we use default
+	if episodeInfo.TranslationID != "" {
+		for _, t := range translations {
+			if t.ID == episodeInfo.TranslationID {
+				translatorID = t.ID
+				break
+			}
+		}
+	}
 	
 	// Extract season_id if it's a series
 	var seasonID string
@@ -327,34 +323,23 @@ func (p *HDRezkaProvider) GetVideoLink(ctx context.Context, episodeInfo *Episode
 	videoMatches := reVideoLinks.FindAllStringSubmatch(decodedStr, -1)
 	
 	if len(videoMatches) == 0 {
-		return nil, fmt.Errorf("no video links found in decoded data")
+		return nil, fmt.Errorf("%w in decoded data", ErrNoVideoLinks)
 	}
 	
-	// Find the best matching quality
-	videoURL := ""
-	if quality == "" || quality == "best" {
-		// Use the last (usually best) quality
-		videoURL = videoMatches[len(videoMatches)-1][2]
-	} else if quality == "worst" {
-		// Use the first (usually worst) quality
-		videoURL = videoMatches[0][2]
-	} else {
-		// Find matching quality
-		for _, match := range videoMatches {
-			if strings.Contains(match[1], quality) {
-				videoURL = match[2]
-				break
-			}
-		}
-		// If no match found, use best quality
-		if videoURL == "" {
-			videoURL = videoMatches[len(videoMatches)-1][2]
+	// Key the links by height ("1080p Ultra" -> "1080"); later entries are the better encodes
+	links := make(map[string]string)
+	for _, match := range videoMatches {
+		height := heightLabel(match[1])
+		if height == "" {
+			continue
 		}
+		// Clean up video URL (remove " or " patterns)
+		links[height] = strings.TrimSpace(strings.Split(match[2], " or ")[0])
 	}
-	
-	// Clean up video URL (remove " or " patterns)
-	videoURL = strings.Split(videoURL, " or ")[0]
-	videoURL = strings.TrimSpace(videoURL)
+	if len(links) == 0 {
+		return nil, fmt.Errorf("%w in decoded data", ErrNoVideoLinks)
+	}
+	videoURL := selectVariant(links, quality)
 	
 	// Extract subtitles
 	var subtitles []string
@@ -372,10 +357,51 @@ func (p *HDRezkaProvider) GetVideoLink(ctx context.Context, episodeInfo *Episode
 		}
 	}
 	
-	return &VideoData{
+	videoData := &VideoData{
 		VideoURL:     videoURL,
 		SubtitleURLs: subtitles,
 		Referer:      "https://hdrezka.website/",
-	}, nil
+		Qualities:    links,
+	}
+	// Offer a choice until one is saved for this anime
+	if episodeInfo.TranslationID == "" && len(translations) > 1 {
+		videoData.Translations = translations
+	}
+	return videoData, nil
+}
+
+// parseHDRezkaTranslations extracts the translator list from a title page, default first
+func parseHDRezkaTranslations(page string, defaultID string) []Translation {
+	reItem := regexp.MustCompile(`<li[^>]*data-translator_id="([0-9]+)"[^>]*>(.*?)</li>`)
+	reTitle := regexp.MustCompile(`title="([^"]*)"`)
+	reTags := regexp.MustCompile(`<[^>]*>`)
+
+	var translations []Translation
+	seen := make(map[string]bool)
+	for _, m := range reItem.FindAllStringSubmatch(page, -1) {
+		id := m[1]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		// Prefer the title attribute; fall back to the item's text
+		name := strings.TrimSpace(html.UnescapeString(reTags.ReplaceAllString(m[2], "")))
+		if t := reTitle.FindStringSubmatch(m[0]); len(t) >= 2 && t[1] != "" {
+			name = html.UnescapeString(t[1])
+		}
+		if name == "" {
+			name = "Translation " + id
+		}
+
+		translation := Translation{ID: id, Name: name}
+		if id == defaultID {
+			translations = append([]Translation{translation}, translations...)
+		} else {
+			translations = append(translations, translation)
+		}
+	}
+
+	return translations
 }
 
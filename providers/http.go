@@ -0,0 +1,51 @@
+package providers
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pranshuj73/oni/logger"
+)
+
+// proxies maps provider names to the proxy their requests go through, from [provider.<name>] proxy
+var proxies map[string]string
+
+// SetProxies sets the per-provider proxies used by providers created afterwards
+func SetProxies(providerProxies map[string]string) {
+	proxies = providerProxies
+}
+
+// newHTTPClient returns the HTTP client a provider makes its requests with
+// It goes through the provider's own proxy when one is set, and through HTTP_PROXY/HTTPS_PROXY otherwise
+func newHTTPClient(provider string) *http.Client {
+	// Configure HTTP client with timeout and connection pooling
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+
+	if proxy := proxies[provider]; proxy != "" {
+		proxyURL, err := url.Parse(proxy)
+		if err != nil {
+			logger.Warn("Ignoring invalid provider proxy", map[string]interface{}{
+				"provider": provider,
+				"error":    err.Error(),
+			})
+		} else {
+			transport.Proxy = http.ProxyURL(proxyURL)
+			logger.Debug("Using provider proxy", map[string]interface{}{
+				"provider": provider,
+				"proxy":    proxyURL.Redacted(),
+			})
+		}
+	}
+
+	return &http.Client{
+		Timeout:   60 * time.Second,
+		Transport: transport,
+	}
+}
@@ -0,0 +1,56 @@
+package providers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// videoLinkTTL is how long resolved links are reused; stream URLs are often signed and expire
+const videoLinkTTL = 5 * time.Minute
+
+// videoLinkEntry is a resolved video kept for a short while
+type videoLinkEntry struct {
+	data    VideoData
+	expires time.Time
+}
+
+var (
+	videoLinksMu sync.Mutex
+	videoLinks   = make(map[string]videoLinkEntry)
+)
+
+// videoLinkKey identifies a resolved video by provider, episode, quality and audio type
+func videoLinkKey(provider string, info *EpisodeInfo, quality string, subOrDub string) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s", provider, info.ShowID, info.EpisodeID, info.MediaType, info.TranslationID, quality, subOrDub)
+}
+
+// loadVideoLink returns a copy of a cached video if it hasn't expired
+func loadVideoLink(key string) (*VideoData, bool) {
+	videoLinksMu.Lock()
+	defer videoLinksMu.Unlock()
+
+	entry, ok := videoLinks[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(videoLinks, key)
+		return nil, false
+	}
+
+	// Callers adjust the returned video (e.g. the picked quality), so hand out a copy
+	data := entry.data
+	return &data, true
+}
+
+// saveVideoLink caches a copy of a resolved video
+func saveVideoLink(key string, data *VideoData) {
+	videoLinksMu.Lock()
+	defer videoLinksMu.Unlock()
+
+	videoLinks[key] = videoLinkEntry{
+		data:    *data,
+		expires: time.Now().Add(videoLinkTTL),
+	}
+}
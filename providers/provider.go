@@ -3,6 +3,8 @@ package providers
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/pranshuj73/oni/logger"
 )
@@ -10,7 +12,9 @@ import (
 // Provider defines the interface for anime providers
 type Provider interface {
 	// GetEpisodeInfo fetches episode information
-	GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error)
+	// episode is the provider's episode number as text, so specials like "6.5" can be requested
+	// titles are the anime's known names, preferred first; the first is used for searching
+	GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error)
 
 	// GetVideoLink extracts the video URL and subtitles
 	GetVideoLink(ctx context.Context, episodeInfo *EpisodeInfo, quality string, subOrDub string) (*VideoData, error)
@@ -21,10 +25,40 @@ type Provider interface {
 
 // EpisodeInfo contains information about an episode
 type EpisodeInfo struct {
-	EpisodeID    string
-	EpisodeTitle string
-	MediaType    string // For hdrezka
-	ShowID       string // For allanime
+	EpisodeID     string
+	EpisodeTitle  string
+	MediaType     string        // For hdrezka
+	ShowID        string        // For allanime, gogoanime and animepahe
+	TranslationID string        // For hdrezka, the saved voiceover choice (empty for the default)
+	Availability  *Availability // Sub/dub episode counts, nil when the provider doesn't report them
+}
+
+// Availability is how many episodes a provider has for each audio type
+type Availability struct {
+	Sub int
+	Dub int
+}
+
+// HasDub reports whether any dubbed episodes exist
+func (a *Availability) HasDub() bool {
+	return a != nil && a.Dub > 0
+}
+
+// Translation is a voiceover/translation track offered by a provider
+type Translation struct {
+	ID   string
+	Name string
+}
+
+// Episode is a single entry in a provider's episode list
+type Episode struct {
+	Number int
+	Title  string
+}
+
+// EpisodeLister is implemented by providers that can list a show's episodes with titles
+type EpisodeLister interface {
+	ListEpisodes(ctx context.Context, mediaID int, titles []string) ([]Episode, error)
 }
 
 // VideoData contains video and subtitle information
@@ -32,6 +66,44 @@ type VideoData struct {
 	VideoURL     string
 	SubtitleURLs []string
 	Referer      string
+	Qualities    map[string]string // All resolved qualities (quality -> URL), if the provider exposes them
+	Translations []Translation     // Tracks to choose from when there are several and none was saved yet
+}
+
+// AvailableQualities lists the qualities the provider resolved, highest first
+// Empty when it only gave a single stream
+func (v *VideoData) AvailableQualities() []string {
+	return SortedQualities(v.Qualities)
+}
+
+// PlayingQuality returns the quality label of VideoURL, or "" when it isn't one of the listed qualities
+func (v *VideoData) PlayingQuality() string {
+	for quality, link := range v.Qualities {
+		if link == v.VideoURL {
+			return quality
+		}
+	}
+	return ""
+}
+
+// SortedQualities returns the quality keys ordered from highest to lowest resolution
+func SortedQualities(qualities map[string]string) []string {
+	keys := make([]string, 0, len(qualities))
+	for q := range qualities {
+		keys = append(keys, q)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		qi, errI := strconv.Atoi(keys[i])
+		qj, errJ := strconv.Atoi(keys[j])
+		// Non-numeric labels (e.g. "best") sort after numeric ones
+		if errI != nil || errJ != nil {
+			return errI == nil
+		}
+		return qi > qj
+	})
+
+	return keys
 }
 
 // GetProvider returns a provider by name, wrapped with retry logic
@@ -58,6 +130,12 @@ func GetProvider(name string) (Provider, error) {
 	case "aniworld":
 		logger.Info("Using AniWorld provider", nil)
 		baseProvider = NewAniWorldProvider()
+	case "gogoanime":
+		logger.Info("Using Gogoanime provider", nil)
+		baseProvider = NewGogoanimeProvider()
+	case "animepahe":
+		logger.Info("Using AnimePahe provider", nil)
+		baseProvider = NewAnimePaheProvider()
 	default:
 		logger.Error("Unknown provider", nil, map[string]interface{}{
 			"provider": name,
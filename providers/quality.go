@@ -0,0 +1,90 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// fetchVariants fetches an HLS master playlist and maps each variant's height to its URL
+// referer is optional; a playlist that isn't a master yields no variants
+func fetchVariants(ctx context.Context, client *http.Client, masterURL, referer string) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", masterURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if referer != "" {
+		req.Header.Set("Referer", referer)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("playlist request failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	baseURL := masterURL[:strings.LastIndex(masterURL, "/")+1]
+	reRes := regexp.MustCompile(`RESOLUTION=\d+x(\d+)`)
+
+	variants := make(map[string]string)
+	lines := strings.Split(string(body), "\n")
+	for i := 0; i < len(lines)-1; i++ {
+		m := reRes.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		next := strings.TrimSpace(lines[i+1])
+		if next == "" || strings.HasPrefix(next, "#") {
+			continue
+		}
+		if !strings.HasPrefix(next, "http") {
+			next = baseURL + next
+		}
+		variants[m[1]] = next
+	}
+
+	return variants, nil
+}
+
+// selectVariant resolves a configured quality against the links a provider found, keyed by height
+// Every provider goes through it so "best", "worst" and a resolution mean the same thing everywhere:
+// an exact match wins, "worst" takes the lowest resolution, and anything else (including "best",
+// "ask" or a resolution the provider doesn't have) takes the highest
+func selectVariant(variants map[string]string, quality string) string {
+	if link, ok := variants[quality]; ok {
+		return link
+	}
+	qualities := SortedQualities(variants)
+	if len(qualities) == 0 {
+		return ""
+	}
+
+	if quality == "worst" {
+		// Non-numeric labels sort last, so the lowest resolution is the last numeric one
+		for i := len(qualities) - 1; i >= 0; i-- {
+			if _, err := strconv.Atoi(qualities[i]); err == nil {
+				return variants[qualities[i]]
+			}
+		}
+	}
+	return variants[qualities[0]]
+}
+
+// heightLabel turns a quality label such as "1080p" or "720p HD" into its height, "" when it has none
+func heightLabel(label string) string {
+	return reHeight.FindString(label)
+}
+
+var reHeight = regexp.MustCompile(`\d+`)
@@ -166,19 +166,63 @@ func (p *ProviderWithRetry) Name() string {
 }
 
 // GetEpisodeInfo wraps the provider's GetEpisodeInfo with retry logic
-func (p *ProviderWithRetry) GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error) {
-	operation := fmt.Sprintf("%s.GetEpisodeInfo(mediaID=%d, episode=%d)", p.provider.Name(), mediaID, episodeNum)
+func (p *ProviderWithRetry) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	operation := fmt.Sprintf("%s.GetEpisodeInfo(mediaID=%d, episode=%s)", p.provider.Name(), mediaID, episode)
 
 	return WithRetryResult(ctx, p.config, operation, func() (*EpisodeInfo, error) {
-		return p.provider.GetEpisodeInfo(ctx, mediaID, episodeNum, title)
+		return p.provider.GetEpisodeInfo(ctx, mediaID, episode, titles)
+	})
+}
+
+// Search wraps the provider's Search with retry logic
+func (p *ProviderWithRetry) Search(ctx context.Context, query string, subOrDub string) ([]SearchResult, error) {
+	searcher, ok := p.provider.(Searcher)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", p.provider.Name(), ErrSearchUnsupported)
+	}
+
+	operation := fmt.Sprintf("%s.Search(query=%s)", p.provider.Name(), query)
+
+	return WithRetryResult(ctx, p.config, operation, func() ([]SearchResult, error) {
+		return searcher.Search(ctx, query, subOrDub)
+	})
+}
+
+// ListEpisodes wraps the provider's ListEpisodes with retry logic
+func (p *ProviderWithRetry) ListEpisodes(ctx context.Context, mediaID int, titles []string) ([]Episode, error) {
+	lister, ok := p.provider.(EpisodeLister)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", p.provider.Name(), ErrListingUnsupported)
+	}
+
+	operation := fmt.Sprintf("%s.ListEpisodes(mediaID=%d)", p.provider.Name(), mediaID)
+
+	return WithRetryResult(ctx, p.config, operation, func() ([]Episode, error) {
+		return lister.ListEpisodes(ctx, mediaID, titles)
 	})
 }
 
 // GetVideoLink wraps the provider's GetVideoLink with retry logic
 func (p *ProviderWithRetry) GetVideoLink(ctx context.Context, episodeInfo *EpisodeInfo, quality string, subOrDub string) (*VideoData, error) {
+	// Reuse links resolved moments ago, e.g. when stopping and resuming the same episode
+	cacheKey := videoLinkKey(p.provider.Name(), episodeInfo, quality, subOrDub)
+	if videoData, ok := loadVideoLink(cacheKey); ok {
+		logger.Debug("Using cached video link", map[string]interface{}{
+			"provider":  p.provider.Name(),
+			"episodeID": episodeInfo.EpisodeID,
+		})
+		return videoData, nil
+	}
+
 	operation := fmt.Sprintf("%s.GetVideoLink(quality=%s, subOrDub=%s)", p.provider.Name(), quality, subOrDub)
 
-	return WithRetryResult(ctx, p.config, operation, func() (*VideoData, error) {
+	videoData, err := WithRetryResult(ctx, p.config, operation, func() (*VideoData, error) {
 		return p.provider.GetVideoLink(ctx, episodeInfo, quality, subOrDub)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	saveVideoLink(cacheKey, videoData)
+	return videoData, nil
 }
@@ -0,0 +1,123 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+// ErrSearchUnsupported is returned by providers that can't search their own catalogue
+var ErrSearchUnsupported = errors.New("search is not supported")
+
+// SearchResult is a show found through a provider's own search, used when AniList is disabled
+type SearchResult struct {
+	MediaID      int // Local ID derived from the show ID, negative so it never collides with AniList IDs
+	ShowID       string
+	Title        string
+	Episodes     int
+	Availability *Availability // Sub/dub episode counts, if the provider reports them
+}
+
+// Searcher is implemented by providers that can search their own catalogue
+type Searcher interface {
+	Search(ctx context.Context, query string, subOrDub string) ([]SearchResult, error)
+}
+
+// LocalMediaID returns a stable negative media ID for a provider show
+func LocalMediaID(provider string, showID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(provider + ":" + showID))
+	return -int(h.Sum32()&0x7fffffff) - 1
+}
+
+// searchResult is a single hit from a provider's own search
+type searchResult struct {
+	Title string
+	ID    string
+}
+
+// normalizeTitle lowercases a title and strips punctuation for comparison
+func normalizeTitle(s string) string {
+	s = strings.ToLower(s)
+	re := regexp.MustCompile(`[^a-z0-9 ]+`)
+	s = re.ReplaceAllString(s, " ")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// primaryTitle returns the title used for searching, which is the first one given
+func primaryTitle(titles []string) string {
+	if len(titles) == 0 {
+		return ""
+	}
+	return titles[0]
+}
+
+// levenshtein returns the number of single-rune edits needed to turn a into b
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// titleSimilarity scores two titles from 0 (nothing alike) to 1 (identical after normalization)
+func titleSimilarity(a, b string) float64 {
+	ra := []rune(normalizeTitle(a))
+	rb := []rune(normalizeTitle(b))
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// bestTitleMatch returns the index of the name most similar to any of the titles
+// Ties keep the provider's own ranking, so the earlier result wins
+func bestTitleMatch(names []string, titles []string) int {
+	if len(names) == 0 {
+		return -1
+	}
+
+	best, bestScore := 0, -1.0
+	for i, name := range names {
+		for _, title := range titles {
+			if score := titleSimilarity(name, title); score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+	}
+
+	return best
+}
+
+// pickSearchResult returns the result whose title best matches any of the anime's titles
+func pickSearchResult(results []searchResult, titles []string) *searchResult {
+	names := make([]string, len(results))
+	for i, result := range results {
+		names[i] = result.Title
+	}
+
+	best := bestTitleMatch(names, titles)
+	if best < 0 {
+		return nil
+	}
+
+	return &results[best]
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
-	"time"
+
+	"github.com/pranshuj73/oni/logger"
 )
 
 // YugenProvider implements the yugen provider
@@ -18,19 +21,8 @@ type YugenProvider struct {
 
 // NewYugenProvider creates a new Yugen provider
 func NewYugenProvider() *YugenProvider {
-	// Configure HTTP client with timeout and connection pooling
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 10,
-		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-	}
-
 	return &YugenProvider{
-		client: &http.Client{
-			Timeout:   60 * time.Second,
-			Transport: transport,
-		},
+		client: newHTTPClient("yugen"),
 	}
 }
 
@@ -40,11 +32,40 @@ func (p *YugenProvider) Name() string {
 }
 
 // GetEpisodeInfo fetches episode information from yugen
-func (p *YugenProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episodeNum int, title string) (*EpisodeInfo, error) {
-	// Fetch yugen URL from mal-backup
-	backupURL := fmt.Sprintf("https://raw.githubusercontent.com/bal-mackup/mal-backup/master/anilist/anime/%d.json", mediaID)
+func (p *YugenProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode string, titles []string) (*EpisodeInfo, error) {
+	title := primaryTitle(titles)
+
+	// Check cache first
+	var animeURL string
+	cached, err := LoadProviderMapping("yugen", mediaID)
+	if err == nil && cached != nil {
+		animeURL = cached.ProviderID
+	}
+
+	if animeURL == "" {
+		animeURL, err = p.lookupBackupURL(ctx, mediaID)
+		if err != nil {
+			// Newer shows are often missing from mal-backup, search yugen directly
+			logger.Warn("mal-backup lookup failed, searching yugen by title", map[string]interface{}{
+				"mediaID": mediaID,
+				"title":   title,
+				"error":   err.Error(),
+			})
+			animeURL, err = p.searchURL(ctx, titles)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Save to cache
+		SaveProviderMapping("yugen", mediaID, animeURL, title)
+	}
+
+	yugenURL := strings.Replace(animeURL, "tv/anime", "tv/watch", 1)
+	watchURL := fmt.Sprintf("%s%s/", yugenURL, episode)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", backupURL, nil)
+	// Fetch episode page
+	req, err := http.NewRequestWithContext(ctx, "GET", watchURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -60,55 +81,81 @@ func (p *YugenProvider) GetEpisodeInfo(ctx context.Context, mediaID int, episode
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	// Extract episode title
+	reTitle := regexp.MustCompile(fmt.Sprintf(`%s\s:\s([^<]*)`, regexp.QuoteMeta(episode)))
+	matchesTitle := reTitle.FindStringSubmatch(string(body))
+
+	epTitle := "Episode " + episode
+	if len(matchesTitle) >= 2 {
+		epTitle = html.UnescapeString(strings.TrimSpace(matchesTitle[1]))
+	}
+
+	// Extract yugen episode ID
+	reID := regexp.MustCompile(`id="main-embed" src=".*/e/([^/]*)/?"`)
+	matchesID := reID.FindStringSubmatch(string(body))
+
+	if len(matchesID) < 2 {
+		return nil, fmt.Errorf("yugen episode ID not found")
+	}
+
+	return &EpisodeInfo{
+		EpisodeID:    matchesID[1],
+		EpisodeTitle: epTitle,
+	}, nil
+}
+
+// lookupBackupURL resolves the yugen anime URL from the mal-backup mapping
+func (p *YugenProvider) lookupBackupURL(ctx context.Context, mediaID int) (string, error) {
+	// Fetch yugen URL from mal-backup
+	body, err := fetchBackup(ctx, p.client, mediaID, "yugen")
+	if err != nil {
+		return "", err
+	}
+
 	// Extract yugen URL
 	re := regexp.MustCompile(`"YugenAnime".*?"url": *"([^"]*)"`)
 	matches := re.FindStringSubmatch(string(body))
 
 	if len(matches) < 2 {
-		return nil, fmt.Errorf("yugen URL not found for media ID %d", mediaID)
+		return "", noMappingError("yugen")
 	}
 
-	yugenURL := strings.Replace(matches[1], "tv/anime", "tv/watch", 1)
-	watchURL := fmt.Sprintf("%s%d/", yugenURL, episodeNum)
+	return matches[1], nil
+}
 
-	// Fetch episode page
-	req, err = http.NewRequestWithContext(ctx, "GET", watchURL, nil)
+// searchURL searches yugen by title and returns the anime URL of the best match
+func (p *YugenProvider) searchURL(ctx context.Context, titles []string) (string, error) {
+	title := primaryTitle(titles)
+	req, err := http.NewRequestWithContext(ctx, "GET",
+		fmt.Sprintf("https://yugenanime.tv/discover/?q=%s", url.QueryEscape(title)), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err = p.client.Do(req)
+	resp, err := p.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return "", fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err = io.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return "", fmt.Errorf("failed to read response: %w", err)
 	}
 
-	// Extract episode title
-	reTitle := regexp.MustCompile(fmt.Sprintf(`%d\s:\s([^<]*)`, episodeNum))
-	matchesTitle := reTitle.FindStringSubmatch(string(body))
-
-	epTitle := fmt.Sprintf("Episode %d", episodeNum)
-	if len(matchesTitle) >= 2 {
-		epTitle = matchesTitle[1]
+	// Result anchors look like: href="/anime/<id>/<slug>/" title="<Title>"
+	reResult := regexp.MustCompile(`href="(/anime/\d+/[^"]*/)"[^>]*title="([^"]*)"`)
+	var results []searchResult
+	for _, m := range reResult.FindAllStringSubmatch(string(body), -1) {
+		results = append(results, searchResult{Title: html.UnescapeString(m[2]), ID: m[1]})
 	}
 
-	// Extract yugen episode ID
-	reID := regexp.MustCompile(`id="main-embed" src=".*/e/([^/]*)/?"`)
-	matchesID := reID.FindStringSubmatch(string(body))
-
-	if len(matchesID) < 2 {
-		return nil, fmt.Errorf("yugen episode ID not found")
+	best := pickSearchResult(results, titles)
+	if best == nil {
+		return "", fmt.Errorf("%w on yugen for %q", ErrShowNotFound, title)
 	}
 
-	return &EpisodeInfo{
-		EpisodeID:    matchesID[1],
-		EpisodeTitle: epTitle,
-	}, nil
+	return "https://yugenanime.tv" + best.ID, nil
 }
 
 // GetVideoLink extracts video links from yugen
@@ -146,18 +193,30 @@ func (p *YugenProvider) GetVideoLink(ctx context.Context, episodeInfo *EpisodeIn
 	}
 
 	if len(videoResp.HLS) == 0 {
-		return nil, fmt.Errorf("no HLS links found")
+		return nil, fmt.Errorf("%w: no HLS links", ErrNoVideoLinks)
 	}
 
 	videoURL := videoResp.HLS[0]
 
-	// Apply quality if specified
+	// Pick the requested variant from the master playlist; "best" and "worst" take the highest and
+	// lowest, and without a quality (or variants) the master stays so the player adapts on its own
+	var variants map[string]string
 	if quality != "" {
-		videoURL = strings.Replace(videoURL, ".m3u8", fmt.Sprintf(".%s.m3u8", quality), 1)
+		v, err := fetchVariants(ctx, p.client, videoURL, "")
+		if err != nil {
+			logger.Debug("Failed to read Yugen playlist variants", map[string]interface{}{
+				"url":   videoURL,
+				"error": err.Error(),
+			})
+		} else if len(v) > 0 {
+			variants = v
+			videoURL = selectVariant(variants, quality)
+		}
 	}
 
 	return &VideoData{
-		VideoURL: videoURL,
+		VideoURL:  videoURL,
+		Qualities: variants,
 	}, nil
 }
 
@@ -24,6 +24,7 @@ type AniListAuth struct {
 	universalKeys UniversalKeys
 	err           string
 	verifying     bool
+	reauth        bool // Opened from Settings, so esc goes back instead of quitting
 	spinner       spinner.Model
 }
 
@@ -61,6 +62,13 @@ func NewAniListAuth(cfg *config.Config) *AniListAuth {
 	return m
 }
 
+// NewAniListReauth creates an authentication screen for replacing an expired or revoked token
+func NewAniListReauth(cfg *config.Config) *AniListAuth {
+	m := NewAniListAuth(cfg)
+	m.reauth = true
+	return m
+}
+
 func (m *AniListAuth) Init() tea.Cmd {
 	return tea.Batch(textinput.Blink, m.spinner.Tick)
 }
@@ -73,6 +81,8 @@ func (m *AniListAuth) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case tea.KeyMsg:
+		// No universal keys here: everything but enter and esc, pasted or typed, goes to the token
+		// input, and ctrl+c is left to the app
 		switch msg.String() {
 		case "enter":
 			if !m.verifying && m.textInput.Value() != "" {
@@ -85,6 +95,9 @@ func (m *AniListAuth) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case "esc":
 			if !m.verifying {
+				if m.reauth {
+					return m, func() tea.Msg { return BackMsg{} }
+				}
 				return m, tea.Quit
 			}
 		}
@@ -118,12 +131,20 @@ func (m *AniListAuth) View() string {
 	s += GetBannerGradient() + "\n"
 	s += m.styles.Subtitle.Render("Oni — Anime Streaming Client") + "\n\n"
 
-	s += m.styles.Title.Render("Welcome to Oni!") + "\n\n"
+	if m.reauth {
+		s += m.styles.Title.Render("Re-authenticate AniList") + "\n\n"
+	} else {
+		s += m.styles.Title.Render("Welcome to Oni!") + "\n\n"
+	}
 
 	if m.verifying {
 		s += m.spinner.View() + " " + m.styles.Info.Render("Verifying token...") + "\n\n"
 	} else {
-		s += m.styles.Info.Render("To use Oni, you need to connect your AniList account.") + "\n\n"
+		if m.reauth {
+			s += m.styles.Info.Render("Paste a new access token to replace the saved one.") + "\n\n"
+		} else {
+			s += m.styles.Info.Render("To use Oni, you need to connect your AniList account.") + "\n\n"
+		}
 
 		s += m.styles.Prompt.Render("Step 1:") + " " + m.styles.Info.Render("Open this URL in your browser:") + "\n"
 		s += m.styles.AnimeTitle.Render("  https://anilist.co/api/v2/oauth/authorize?client_id=32038&response_type=token") + "\n\n"
@@ -139,6 +160,10 @@ func (m *AniListAuth) View() string {
 	}
 
 	// Help
+	escHelp := "quit"
+	if m.reauth {
+		escHelp = "back"
+	}
 	helpKeys := anilistAuthKeyMap{
 		Enter: key.NewBinding(
 			key.WithKeys("Enter"),
@@ -146,7 +171,7 @@ func (m *AniListAuth) View() string {
 		),
 		Esc: key.NewBinding(
 			key.WithKeys("Esc"),
-			key.WithHelp("esc", "quit"),
+			key.WithHelp("esc", escHelp),
 		),
 	}
 
@@ -175,7 +200,7 @@ func (m *AniListAuth) verifyToken(token string) tea.Cmd {
 			return AniListAuthErrorMsg{Err: fmt.Errorf("failed to save token: %w", err)}
 		}
 
-		// Get and save user ID
+		// Save the user ID the client fetched while checking the token
 		userID, err := client.GetUserID(context.Background())
 		if err != nil {
 			return AniListAuthErrorMsg{Err: fmt.Errorf("failed to get user ID: %w", err)}
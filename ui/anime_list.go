@@ -3,19 +3,27 @@ package ui
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pranshuj73/oni/anilist"
 	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // AnimeListState represents the list state
@@ -27,15 +35,29 @@ const (
 	ListSearchInput
 	ListSearchResults
 	ListSearchLoading
+	ListBatchStatus   // Picking the status to move the marked shows to
+	ListBatchUpdating // Moving the marked shows one by one
+	ListNotesEdit     // Editing the notes on the highlighted entry
 )
 
+// batchUpdateDelay spaces out batch mutations; AniList allows about 90 requests a minute
+const batchUpdateDelay = 700 * time.Millisecond
+
 // AnimeItem represents an anime entry in the list
 type AnimeItem struct {
-	Entry anilist.MediaListEntry
+	Entry    anilist.MediaListEntry
+	Selected bool // Marked for a batch status change
 }
 
 func (i AnimeItem) Title() string {
-	return i.Entry.Media.Title.UserPreferred
+	title := i.Entry.Media.Title.UserPreferred
+	if i.Entry.Media.IsFavourite {
+		title = "★ " + title
+	}
+	if i.Selected {
+		title = "✓ " + title
+	}
+	return title
 }
 
 func (i AnimeItem) Description() string {
@@ -44,6 +66,15 @@ func (i AnimeItem) Description() string {
 		episodesTotal = fmt.Sprintf("%d", *i.Entry.Media.Episodes)
 	}
 	desc := fmt.Sprintf("Progress: %d/%s episodes", i.Entry.Progress, episodesTotal)
+	if i.Entry.Private {
+		desc += " • private"
+	}
+	if i.Entry.Notes != "" {
+		desc += " • notes"
+	}
+	if i.Entry.Repeat > 0 {
+		desc += fmt.Sprintf(" • Rewatched %d×", i.Entry.Repeat)
+	}
 	if i.Entry.Score != nil && *i.Entry.Score > 0 {
 		desc += fmt.Sprintf(" • Score: %.0f", *i.Entry.Score)
 	}
@@ -101,6 +132,16 @@ type AnimeList struct {
 	searchList    list.Model
 	// Cache tracking
 	lastCacheTimestamp time.Time // Track when we last loaded from cache
+	// Batch status change
+	selected    map[int]bool // Media IDs marked with space
+	batchCursor int
+	batchStatus string
+	batchQueue  []anilist.MediaListEntry
+	batchDone   int
+	batchFailed int
+	// Notes editing
+	notesInput textinput.Model
+	notesEntry anilist.MediaListEntry
 }
 
 // animeListKeyMap defines the keybindings for the anime list
@@ -111,8 +152,17 @@ type animeListKeyMap struct {
 	Right         key.Binding
 	Select        key.Binding
 	SelectEpisode key.Binding
+	PlayNext      key.Binding
 	Search        key.Binding
 	Refresh       key.Binding
+	HardRefresh   key.Binding
+	Random        key.Binding
+	Trailer       key.Binding
+	Related       key.Binding
+	Mark          key.Binding
+	Favourite     key.Binding
+	Notes         key.Binding
+	Batch         key.Binding
 	Back          key.Binding
 }
 
@@ -169,8 +219,9 @@ func (k animeListKeyMap) ShortHelp() []key.Binding {
 func (k animeListKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Left, k.Right, k.Up, k.Down},
-		{k.Select, k.SelectEpisode, k.Search, k.Refresh},
-		{k.Back},
+		{k.Select, k.SelectEpisode, k.PlayNext, k.Search},
+		{k.Refresh, k.HardRefresh, k.Random, k.Trailer, k.Related},
+		{k.Mark, k.Batch, k.Favourite, k.Notes, k.Back},
 	}
 }
 
@@ -201,6 +252,10 @@ func DefaultAnimeListKeyMap() animeListKeyMap {
 			key.WithKeys("p"),
 			key.WithHelp("p", "select episode"),
 		),
+		PlayNext: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "play next unwatched"),
+		),
 		Search: key.NewBinding(
 			key.WithKeys("n", "s"),
 			key.WithHelp("n/s", "search"),
@@ -209,6 +264,38 @@ func DefaultAnimeListKeyMap() animeListKeyMap {
 			key.WithKeys("r"),
 			key.WithHelp("r", "refresh"),
 		),
+		HardRefresh: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "clear cache & resync"),
+		),
+		Random: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "surprise me"),
+		),
+		Trailer: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "trailer"),
+		),
+		Related: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "sequels & related"),
+		),
+		Mark: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "mark"),
+		),
+		Batch: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "move marked"),
+		),
+		Favourite: key.NewBinding(
+			key.WithKeys("*"),
+			key.WithHelp("*", "favourite"),
+		),
+		Notes: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit notes"),
+		),
 		Back: key.NewBinding(
 			key.WithKeys("esc", "ctrl+c"),
 			key.WithHelp("esc", "back"),
@@ -222,6 +309,12 @@ var cacheValid = false
 var cacheInitialized = false
 var cacheTimestamp time.Time
 
+// lastTabIndex remembers the selected tab for the session so re-entering the list keeps it
+var lastTabIndex = 0
+
+// lastSelectedMediaID remembers the show picked from the list so the cursor returns to it after playback
+var lastSelectedMediaID = 0
+
 // CacheData represents the cache file structure
 type CacheData struct {
 	Entries   map[string][]anilist.MediaListEntry `json:"entries"`
@@ -230,15 +323,15 @@ type CacheData struct {
 
 // getCachePath returns the path to the cache file
 func getCachePath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	cacheDir, err := utils.CacheDir()
 	if err != nil {
 		return "", err
 	}
-	cacheDir := filepath.Join(homeDir, ".oni", "cache")
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+	cachePath := filepath.Join(cacheDir, "anime_list_cache.json")
+	if err := utils.MigrateLegacyFile(cachePath, filepath.Join("cache", "anime_list_cache.json")); err != nil {
 		return "", err
 	}
-	return filepath.Join(cacheDir, "anime_list_cache.json"), nil
+	return cachePath, nil
 }
 
 // loadCacheFromDisk loads the cache from disk - ALWAYS valid, never expires
@@ -266,8 +359,19 @@ func reloadCacheFromDisk() {
 
 	var cacheData CacheData
 	if err := json.Unmarshal(data, &cacheData); err != nil {
-		// Invalid cache, will load from API
+		// Invalid cache, will load from API. Move the bad file aside so the next save replaces it
 		cacheValid = false
+		backupPath := cachePath + ".bak"
+		if renameErr := os.Rename(cachePath, backupPath); renameErr != nil {
+			logger.Error("Failed to move corrupt list cache aside", renameErr, map[string]interface{}{
+				"path": cachePath,
+			})
+			return
+		}
+		logger.Warn("List cache was corrupt, refetching from AniList", map[string]interface{}{
+			"error":  err.Error(),
+			"backup": backupPath,
+		})
 		return
 	}
 
@@ -277,6 +381,43 @@ func reloadCacheFromDisk() {
 	cacheValid = true
 }
 
+// OnAniListList reports whether mediaID is on one of the user's status lists, going by the cached lists
+// known is false when there are no cached lists to go by
+func OnAniListList(mediaID int) (onList, known bool) {
+	loadCacheFromDisk()
+	if !cacheValid {
+		return false, false
+	}
+	for _, status := range listStatuses {
+		for _, entry := range animeListCache[status] {
+			if entry.Media.ID == mediaID {
+				return true, true
+			}
+		}
+	}
+	return false, true
+}
+
+// ClearListCache deletes the cached lists from memory and disk so the next load refetches everything
+func ClearListCache() error {
+	animeListCache = make(map[string][]anilist.MediaListEntry)
+	cacheValid = false
+	cacheTimestamp = time.Time{}
+
+	cachePath, err := getCachePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove list cache: %w", err)
+	}
+
+	logger.Info("List cache cleared", map[string]interface{}{
+		"path": cachePath,
+	})
+	return nil
+}
+
 // saveCacheToDisk saves the cache to disk
 func saveCacheToDisk() {
 	cachePath, err := getCachePath()
@@ -299,11 +440,15 @@ func saveCacheToDisk() {
 	os.WriteFile(cachePath, data, 0644)
 }
 
-// buildListItems converts MediaListEntry slice to list.Item slice
-func buildListItems(entries []anilist.MediaListEntry) []list.Item {
-	items := make([]list.Item, len(entries))
-	for i, entry := range entries {
-		items[i] = AnimeItem{Entry: entry}
+// buildListItems converts MediaListEntry slice to list.Item slice, favourites first
+func buildListItems(entries []anilist.MediaListEntry, selected map[int]bool) []list.Item {
+	items := make([]list.Item, 0, len(entries))
+	for _, favourite := range []bool{true, false} {
+		for _, entry := range entries {
+			if entry.Media.IsFavourite == favourite {
+				items = append(items, AnimeItem{Entry: entry, Selected: selected[entry.Media.ID]})
+			}
+		}
 	}
 	return items
 }
@@ -311,7 +456,7 @@ func buildListItems(entries []anilist.MediaListEntry) []list.Item {
 // createListForStatus creates a list component for a given status
 func (m *AnimeList) createListForStatus(status string, width, height int) list.Model {
 	entries := m.entries[status]
-	items := buildListItems(entries)
+	items := buildListItems(entries, m.selected)
 	
 	delegate := list.NewDefaultDelegate()
 	delegate.Styles.SelectedTitle = lipgloss.NewStyle().
@@ -363,9 +508,31 @@ func (m *AnimeList) getStatusIndex(status string) int {
 	return 0
 }
 
+// syncCustomTabs puts a read-only tab after the status tabs for each custom list in the entries
+func (m *AnimeList) syncCustomTabs() {
+	var names []string
+	for key := range m.entries {
+		if isCustomList(key) {
+			names = append(names, strings.TrimPrefix(key, customListPrefix))
+		}
+	}
+	sort.Strings(names)
+
+	m.statuses = m.statuses[:len(listStatuses)]
+	m.statusLabels = m.statusLabels[:len(listStatuses)]
+	for _, name := range names {
+		m.statuses = append(m.statuses, customListPrefix+name)
+		m.statusLabels = append(m.statusLabels, name)
+	}
+	if m.tabIndex >= len(m.statuses) {
+		m.tabIndex = len(m.statuses) - 1
+	}
+}
+
 // updateListsForAllStatuses creates/updates lists for all statuses
 // It preserves filter state if a list is currently being filtered
 func (m *AnimeList) updateListsForAllStatuses() {
+	m.syncCustomTabs()
 	for _, status := range m.statuses {
 		oldList, exists := m.lists[status]
 		// Preserve filter state if list exists and is currently filtering or has filter applied
@@ -378,6 +545,15 @@ func (m *AnimeList) updateListsForAllStatuses() {
 		
 		// Create new list with updated items
 		newList := m.createListForStatus(status, m.width, m.height)
+
+		// Keep the cursor on the same show: the one under it before the rebuild, else the last one played
+		selectedID := lastSelectedMediaID
+		if exists {
+			if item, ok := oldList.SelectedItem().(AnimeItem); ok {
+				selectedID = item.Entry.Media.ID
+			}
+		}
+		selectMediaID(&newList, selectedID)
 		
 		// Restore filter state if it was filtering or had filter applied
 		// SetFilterText automatically applies the filter and sets state to FilterApplied
@@ -393,6 +569,25 @@ func (m *AnimeList) updateListsForAllStatuses() {
 	}
 }
 
+// selectMediaID moves the list cursor to the entry for mediaID, if present
+func selectMediaID(l *list.Model, mediaID int) {
+	if mediaID == 0 {
+		return
+	}
+	for i, item := range l.Items() {
+		if animeItem, ok := item.(AnimeItem); ok && animeItem.Entry.Media.ID == mediaID {
+			l.Select(i)
+			return
+		}
+	}
+}
+
+// listStatuses are the AniList statuses with a tab of their own, in tab order
+var listStatuses = []string{"CURRENT", "REPEATING", "COMPLETED", "PAUSED", "DROPPED", "PLANNING"}
+
+// listStatusLabels are the tab labels of listStatuses
+var listStatusLabels = []string{"Watching", "Rewatching", "Completed", "Paused", "Dropped", "Plan to Watch"}
+
 // NewAnimeList creates a new anime list
 func NewAnimeList(cfg *config.Config, client *anilist.Client) *AnimeList {
 	// Load cache from disk on first access
@@ -408,23 +603,10 @@ func NewAnimeList(cfg *config.Config, client *anilist.Client) *AnimeList {
 		client: client,
 		styles: DefaultStyles(),
 		state:  ListLoading,
-		statuses: []string{
-			"CURRENT",
-			"REPEATING",
-			"COMPLETED",
-			"PAUSED",
-			"DROPPED",
-			"PLANNING",
-		},
-		statusLabels: []string{
-			"Watching",
-			"Rewatching",
-			"Completed",
-			"Paused",
-			"Dropped",
-			"Plan to Watch",
-		},
-		tabIndex:     0,
+		statuses:     append([]string(nil), listStatuses...),
+		statusLabels: append([]string(nil), listStatusLabels...),
+		tabIndex:     lastTabIndex,
+		selected:     make(map[int]bool),
 		entries:      make(map[string][]anilist.MediaListEntry),
 		lists:        make(map[string]list.Model),
 		width:        80,
@@ -455,6 +637,10 @@ func NewAnimeList(cfg *config.Config, client *anilist.Client) *AnimeList {
 			// Initialize lists from cache
 			al.updateListsForAllStatuses()
 		}
+	// A restored session file could point past the last tab
+	if al.tabIndex < 0 || al.tabIndex >= len(al.statuses) {
+		al.tabIndex = 0
+	}
 
 	return al
 }
@@ -463,13 +649,9 @@ func NewAnimeList(cfg *config.Config, client *anilist.Client) *AnimeList {
 func (m *AnimeList) Init() tea.Cmd {
 	if m.cacheLoaded {
 		// Cache exists! Show immediately and refresh in background if needed
-		// Check if cache is recent (less than 5 minutes old)
-		if !cacheTimestamp.IsZero() {
-			timeSinceUpdate := time.Since(cacheTimestamp)
-			if timeSinceUpdate < 5*time.Minute {
-				// Cache is fresh, skip refresh
-				return tea.Batch(m.spinner.Tick)
-			}
+		if cacheFresh(m.cfg) {
+			// Cache is fresh, skip refresh
+			return tea.Batch(m.spinner.Tick)
 		}
 		// Cache is stale or timestamp unknown, refresh in background
 		m.isRefreshing = true
@@ -479,63 +661,160 @@ func (m *AnimeList) Init() tea.Cmd {
 	return tea.Batch(m.spinner.Tick, m.fetchAllLists)
 }
 
+// TrailerRequestMsg asks the app to play an anime's trailer
+type TrailerRequestMsg struct {
+	Anime anilist.Anime
+}
+
+// RelationsRequestMsg asks the app to show an anime's related entries
+type RelationsRequestMsg struct {
+	Anime anilist.Anime
+}
+
 // AllListsResultMsg is sent when all lists are ready
 type AllListsResultMsg struct {
 	AllEntries  map[string][]anilist.MediaListEntry
 	Err         error
+	PartialErr  error // Some statuses failed; AllEntries falls back to cached data for them
 	IsRefresh   bool
 }
 
-// searchAnime performs the search
-func (m *AnimeList) searchAnime() tea.Msg {
-	results, err := m.client.SearchAnime(context.Background(), m.searchInput, m.cfg.Advanced.ShowAdultContent)
-	return SearchResultMsg{Results: results, Err: err}
+// BatchStatusStepMsg is sent after each show in a batch status change is updated
+type BatchStatusStepMsg struct {
+	Err error
 }
 
-// fetchAllLists fetches all anime lists at once (synchronous)
-func (m *AnimeList) fetchAllLists() tea.Msg {
+// maxListWorkers bounds concurrent list requests to stay clear of AniList's rate limit
+const maxListWorkers = 3
+
+// customListPrefix marks the keys of custom lists among the status keys of the list cache
+const customListPrefix = "custom:"
+
+// isCustomList reports whether a list key belongs to one of the user's custom lists
+func isCustomList(key string) bool {
+	return strings.HasPrefix(key, customListPrefix)
+}
+
+// fetchStatusLists fetches the lists for all statuses concurrently
+// It returns the lists that succeeded along with the joined errors of those that failed
+// Custom lists are pieced together from every status and keyed with customListPrefix
+func fetchStatusLists(client *anilist.Client, statuses []string) (map[string][]anilist.MediaListEntry, error) {
+	type statusResult struct {
+		status  string
+		entries []anilist.MediaListEntry
+		custom  map[string][]anilist.MediaListEntry
+		err     error
+	}
+
+	results := make(chan statusResult, len(statuses))
+	sem := make(chan struct{}, maxListWorkers)
+	var wg sync.WaitGroup
+
+	for _, status := range statuses {
+		wg.Add(1)
+		go func(status string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			entries, custom, err := client.GetAnimeList(context.Background(), status)
+			results <- statusResult{status: status, entries: entries, custom: custom, err: err}
+		}(status)
+	}
+
+	wg.Wait()
+	close(results)
+
 	allEntries := make(map[string][]anilist.MediaListEntry)
-	
-	for _, status := range m.statuses {
-		entries, err := m.client.GetAnimeList(context.Background(), status)
-		if err != nil {
-			return AllListsResultMsg{Err: err, IsRefresh: false}
+	var errs []error
+	for result := range results {
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.status, result.err))
+			continue
+		}
+		allEntries[result.status] = result.entries
+		for name, entries := range result.custom {
+			allEntries[customListPrefix+name] = append(allEntries[customListPrefix+name], entries...)
 		}
-		allEntries[status] = entries
 	}
-	
-	// Update cache (both memory and disk)
-	animeListCache = allEntries
-	cacheValid = true
-	saveCacheToDisk()
-	
-	return AllListsResultMsg{AllEntries: allEntries, Err: nil, IsRefresh: false}
+
+	return allEntries, errors.Join(errs...)
 }
 
-// fetchAllListsAsync fetches all anime lists in the background (for cache refresh)
-func (m *AnimeList) fetchAllListsAsync() tea.Msg {
+// updateListCache stores freshly fetched lists, keeping cached lists for statuses that failed
+func updateListCache(fresh map[string][]anilist.MediaListEntry, statuses []string) map[string][]anilist.MediaListEntry {
 	allEntries := make(map[string][]anilist.MediaListEntry)
-	
-	for _, status := range m.statuses {
-		entries, err := m.client.GetAnimeList(context.Background(), status)
-		if err != nil {
-			// Silently fail for background refresh
-			return AllListsResultMsg{AllEntries: animeListCache, Err: nil, IsRefresh: true}
+	complete := true
+	for _, status := range statuses {
+		if entries, ok := fresh[status]; ok {
+			allEntries[status] = entries
+		} else {
+			complete = false
+			if entries, ok := animeListCache[status]; ok {
+				allEntries[status] = entries
+			}
 		}
-		allEntries[status] = entries
 	}
-	
+
+	// Custom lists span every status, so they are only replaced when all statuses came back
+	customSource := fresh
+	if !complete {
+		customSource = animeListCache
+	}
+	for key, entries := range customSource {
+		if isCustomList(key) {
+			allEntries[key] = entries
+		}
+	}
+
 	// Update cache (both memory and disk)
 	animeListCache = allEntries
 	cacheValid = true
 	saveCacheToDisk()
-	
-	return AllListsResultMsg{AllEntries: allEntries, Err: nil, IsRefresh: true}
+
+	return allEntries
+}
+
+// searchAnime performs the search
+func (m *AnimeList) searchAnime() tea.Msg {
+	results, err := searchAniList(m.client, m.searchInput, m.cfg.Advanced.ShowAdultContent)
+	return SearchResultMsg{Results: results, Err: err}
+}
+
+// fetchAllLists fetches all anime lists at once
+func (m *AnimeList) fetchAllLists() tea.Msg {
+	fresh, err := fetchStatusLists(m.client, m.statuses)
+	if len(fresh) == 0 {
+		return AllListsResultMsg{Err: err, IsRefresh: false}
+	}
+
+	allEntries := updateListCache(fresh, m.statuses)
+	return AllListsResultMsg{AllEntries: allEntries, PartialErr: err, IsRefresh: false}
+}
+
+// fetchAllListsAsync fetches all anime lists in the background (for cache refresh)
+func (m *AnimeList) fetchAllListsAsync() tea.Msg {
+	fresh, err := fetchStatusLists(m.client, m.statuses)
+	if len(fresh) == 0 {
+		// Silently fail for background refresh
+		return AllListsResultMsg{AllEntries: animeListCache, Err: nil, IsRefresh: true}
+	}
+
+	allEntries := updateListCache(fresh, m.statuses)
+	return AllListsResultMsg{AllEntries: allEntries, PartialErr: err, IsRefresh: true}
+}
+
+// cacheFresh reports whether the cached lists were fetched less than cache_refresh_minutes ago
+func cacheFresh(cfg *config.Config) bool {
+	if cacheTimestamp.IsZero() {
+		return false
+	}
+	return time.Since(cacheTimestamp) < time.Duration(cfg.AniList.CacheRefreshMinutes)*time.Minute
 }
 
 // RefreshCacheInBackground refreshes the anime list cache in the background
 // This can be called on app startup to pre-warm the cache
-// It skips refresh if cache was updated less than 5 minutes ago to prevent rate limits
+// It skips refresh if cache was updated less than cache_refresh_minutes ago to prevent rate limits
 func RefreshCacheInBackground(cfg *config.Config, client *anilist.Client) {
 	if client == nil || cfg.AniList.NoAniList {
 		return
@@ -544,13 +823,9 @@ func RefreshCacheInBackground(cfg *config.Config, client *anilist.Client) {
 	// Load cache from disk first
 	loadCacheFromDisk()
 	
-	// Check if cache is recent (less than 5 minutes old)
-	if cacheValid && !cacheTimestamp.IsZero() {
-		timeSinceUpdate := time.Since(cacheTimestamp)
-		if timeSinceUpdate < 5*time.Minute {
-			// Cache is fresh, skip refresh
-			return
-		}
+	if cacheValid && cacheFresh(cfg) {
+		// Cache is fresh, skip refresh
+		return
 	}
 	
 	// Start background refresh
@@ -558,7 +833,7 @@ func RefreshCacheInBackground(cfg *config.Config, client *anilist.Client) {
 }
 
 // ForceRefreshCacheInBackground forces a cache refresh in the background
-// This bypasses the 5-minute freshness check and is used when updates are made
+// This bypasses the cache_refresh_minutes freshness check and is used when updates are made
 func ForceRefreshCacheInBackground(cfg *config.Config, client *anilist.Client) {
 	if client == nil || cfg.AniList.NoAniList {
 		return
@@ -567,24 +842,166 @@ func ForceRefreshCacheInBackground(cfg *config.Config, client *anilist.Client) {
 	// Start background refresh
 	go func() {
 		statuses := []string{"CURRENT", "PLANNING", "COMPLETED", "DROPPED", "PAUSED", "REPEATING"}
-		allEntries := make(map[string][]anilist.MediaListEntry)
-		
-		for _, status := range statuses {
-			entries, err := client.GetAnimeList(context.Background(), status)
-			if err != nil {
-				// Silently fail for background refresh, keep existing cache
-				return
-			}
-			allEntries[status] = entries
+		fresh, err := fetchStatusLists(client, statuses)
+		if err != nil {
+			logger.Warn("Background list refresh incomplete", map[string]interface{}{
+				"fetched": len(fresh),
+				"error":   err.Error(),
+			})
 		}
-		
-		// Update cache (both memory and disk)
-		animeListCache = allEntries
-		cacheValid = true
-		saveCacheToDisk()
+		if len(fresh) == 0 {
+			// Silently fail for background refresh, keep existing cache
+			return
+		}
+		updateListCache(fresh, statuses)
 	}()
 }
 
+// listLabelFor returns the label of the list holding mediaID, or "" when it's on none
+func (m *AnimeList) listLabelFor(mediaID int) string {
+	for i, status := range m.statuses {
+		for _, entry := range m.entries[status] {
+			if entry.Media.ID == mediaID {
+				return m.statusLabels[i]
+			}
+		}
+	}
+	return ""
+}
+
+// Typing reports whether keys are going into the search query or a list filter
+// Screens embedding the list use it to stop their own shortcuts from catching typed letters
+func (m *AnimeList) Typing() bool {
+	if m.state == ListSearchInput || m.state == ListNotesEdit {
+		return true
+	}
+	if m.state == ListSearchResults {
+		return m.searchList.FilterState() == list.Filtering
+	}
+	if m.state == ListResults && len(m.statuses) > 0 {
+		return m.lists[m.statuses[m.tabIndex]].FilterState() == list.Filtering
+	}
+	return false
+}
+
+// entryFor returns the user's list entry for a show, or nil when it isn't on any list
+func (m *AnimeList) entryFor(mediaID int) *anilist.MediaListEntry {
+	for _, status := range m.statuses {
+		for _, entry := range m.entries[status] {
+			if entry.Media.ID == mediaID {
+				return &entry
+			}
+		}
+	}
+	return nil
+}
+
+// markedEntries returns the marked shows that aren't already in the target status
+func (m *AnimeList) markedEntries(target string) []anilist.MediaListEntry {
+	var marked []anilist.MediaListEntry
+	for _, status := range m.statuses {
+		// Shows on custom lists are on a status tab as well
+		if status == target || isCustomList(status) {
+			continue
+		}
+		for _, entry := range m.entries[status] {
+			if m.selected[entry.Media.ID] {
+				marked = append(marked, entry)
+			}
+		}
+	}
+	return marked
+}
+
+// batchUpdateStep moves one show in a batch to m.batchStatus after waiting delay
+// When AniList says we're going too fast, it waits as asked and tries once more
+func (m *AnimeList) batchUpdateStep(entry anilist.MediaListEntry, delay time.Duration) tea.Cmd {
+	status := m.batchStatus
+	return func() tea.Msg {
+		time.Sleep(delay)
+		err := m.client.UpdateStatus(context.Background(), entry.Media.ID, status)
+		var rateErr *anilist.RateLimitError
+		if errors.As(err, &rateErr) {
+			logger.Warn("Batch status update rate limited, waiting", map[string]interface{}{
+				"mediaID":    entry.Media.ID,
+				"retryAfter": rateErr.RetryAfter.String(),
+			})
+			time.Sleep(rateErr.RetryAfter)
+			err = m.client.UpdateStatus(context.Background(), entry.Media.ID, status)
+		}
+		return BatchStatusStepMsg{Err: err}
+	}
+}
+
+// NotesSavedMsg reports notes saved on a list entry
+type NotesSavedMsg struct {
+	Anime anilist.Anime
+	Notes string
+	Err   error
+}
+
+// openNotes starts editing the notes on an entry
+func (m *AnimeList) openNotes(entry anilist.MediaListEntry) {
+	m.notesEntry = entry
+	m.notesInput = textinput.New()
+	m.notesInput.Placeholder = "e.g. watched up to ep 5 on the plane"
+	m.notesInput.CharLimit = 0
+	m.notesInput.Width = max(20, m.width-4)
+	m.notesInput.SetValue(entry.Notes)
+	m.notesInput.Focus()
+	m.state = ListNotesEdit
+}
+
+// saveNotes sends the edited notes to AniList
+func (m *AnimeList) saveNotes(anime anilist.Anime, notes string) tea.Cmd {
+	return func() tea.Msg {
+		err := m.client.UpdateNotes(context.Background(), anime.ID, notes)
+		return NotesSavedMsg{Anime: anime, Notes: notes, Err: err}
+	}
+}
+
+// FavouriteToggledMsg reports a show added to or removed from AniList favourites
+type FavouriteToggledMsg struct {
+	Anime     anilist.Anime
+	Favourite bool // Whether the show is a favourite now
+	Err       error
+}
+
+// toggleFavourite flips a show's favourite on AniList
+func (m *AnimeList) toggleFavourite(anime anilist.Anime) tea.Cmd {
+	return func() tea.Msg {
+		err := m.client.ToggleFavourite(context.Background(), anime.ID)
+		return FavouriteToggledMsg{Anime: anime, Favourite: !anime.IsFavourite, Err: err}
+	}
+}
+
+// finishBatch clears the marks and reports how the batch status change went
+func (m *AnimeList) finishBatch() tea.Cmd {
+	total := len(m.batchQueue)
+	moved := total - m.batchFailed
+	label := m.statusLabels[m.getStatusIndex(m.batchStatus)]
+
+	logger.Info("Batch status change finished", map[string]interface{}{
+		"status": m.batchStatus,
+		"moved":  moved,
+		"failed": m.batchFailed,
+	})
+
+	m.selected = make(map[int]bool)
+	m.batchQueue = nil
+	m.state = ListResults
+	m.updateListsForAllStatuses()
+	if moved > 0 {
+		ForceRefreshCacheInBackground(m.cfg, m.client)
+	}
+
+	toast := ToastMsg{Text: fmt.Sprintf("Moved %d shows to %s", moved, label), Kind: ToastSuccess}
+	if m.batchFailed > 0 {
+		toast = ToastMsg{Text: fmt.Sprintf("Moved %d of %d shows to %s - %d failed", moved, total, label, m.batchFailed), Kind: ToastError}
+	}
+	return func() tea.Msg { return toast }
+}
+
 // Update handles messages
 func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -689,74 +1106,44 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			currentStatus := m.statuses[m.tabIndex]
 			currentList := m.lists[currentStatus]
 			
-			// Check filter state before updating (to detect state transitions)
-			wasFiltering := currentList.FilterState() == list.Filtering
-			hadFilterApplied := currentList.FilterState() == list.FilterApplied
-			isEsc := msg.String() == "esc"
-			
 			// Always update the list first so it can process the key and enter filtering mode
+			before := currentList.FilterState()
 			currentList, cmd = currentList.Update(msg)
 			m.lists[currentStatus] = currentList
 			cmds = append(cmds, cmd)
-			
-			// Check filter state after updating
+
+			// Keys that opened, edited, confirmed or cleared the filter are done
 			filterState := currentList.FilterState()
-			
-			// If Esc was pressed and filter was active, the list cleared it - don't go back
-			if isEsc && (wasFiltering || hadFilterApplied) {
-				// Filter was cleared by the list, just return
+			if classifyFilterKey(before, filterState, msg.String()) != filterKeyPassThrough {
 				return m, tea.Batch(cmds...)
 			}
-			
-			// If we're filtering (actively typing) or filter is applied, handle keys specially
-			if filterState == list.Filtering || filterState == list.FilterApplied {
-				// For keys while actively filtering, let the list handle everything
-				if filterState == list.Filtering {
-					return m, tea.Batch(cmds...)
-				}
-			}
-			
-			// Check if Enter was pressed while filtering (confirms filter, don't select)
-			justConfirmedFilter := wasFiltering && 
-				filterState == list.FilterApplied && 
-				msg.String() == "enter"
-			
-			// If Enter just confirmed the filter, don't handle it as selection
-			if justConfirmedFilter {
+
+			// Esc drops the marks before it leaves the list
+			if msg.String() == "esc" && len(m.selected) > 0 {
+				m.selected = make(map[int]bool)
+				m.updateListsForAllStatuses()
 				return m, tea.Batch(cmds...)
 			}
 			
-			// Handle universal keys (but skip Esc if filter is active - already handled above)
-			if m.state != ListSearchInput {
-				// Don't handle Esc as quit if filter is active
-				if msg.String() != "esc" || (filterState != list.Filtering && filterState != list.FilterApplied) {
-					switch {
-					case key.Matches(msg, m.universalKeys.Help):
-						m.help.ShowAll = !m.help.ShowAll
-						return m, nil
-					case key.Matches(msg, m.universalKeys.Quit):
-						return m, func() tea.Msg { return BackMsg{} }
-					}
-				}
+			// Handle universal keys; an esc that belonged to the filter has returned above
+			switch {
+			case key.Matches(msg, m.universalKeys.Help):
+				m.help.ShowAll = !m.help.ShowAll
+				return m, nil
+			case key.Matches(msg, m.universalKeys.Quit):
+				return m, func() tea.Msg { return BackMsg{} }
 			}
-			
+
 			// Handle tab switching and other special keys
-			// Esc is already handled above when filter is active
 			switch msg.String() {
-			case "ctrl+c":
+			case "ctrl+c", "esc":
 				return m, func() tea.Msg { return BackMsg{} }
-			case "esc":
-				// Only handle Esc as back if filter is not active
-				if filterState != list.Filtering && filterState != list.FilterApplied {
-					return m, func() tea.Msg { return BackMsg{} }
-				}
-				// If filter is active, it's already been handled above
-				return m, tea.Batch(cmds...)
 
 			case "left", "h":
 				// Switch to previous tab
 				if m.tabIndex > 0 {
 					m.tabIndex--
+					lastTabIndex = m.tabIndex
 				}
 				return m, tea.Batch(cmds...)
 
@@ -764,6 +1151,7 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Switch to next tab
 				if m.tabIndex < len(m.statuses)-1 {
 					m.tabIndex++
+					lastTabIndex = m.tabIndex
 				}
 				return m, tea.Batch(cmds...)
 
@@ -775,6 +1163,80 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, tea.Batch(cmds...)
 
+			case "R":
+				// Hard refresh: drop the cache entirely and load from scratch
+				if m.isRefreshing {
+					return m, tea.Batch(cmds...)
+				}
+				if err := ClearListCache(); err != nil {
+					logger.Error("Failed to clear list cache", err, nil)
+				}
+				m.entries = make(map[string][]anilist.MediaListEntry)
+				m.lists = make(map[string]list.Model)
+				m.cacheLoaded = false
+				m.state = ListLoading
+				m.isRefreshing = true
+				return m, tea.Batch(append(cmds, m.fetchAllLists)...)
+
+			case "x":
+				// Surprise me: pick a random show from this tab and go to episode selection
+				entries := m.entries[currentStatus]
+				if len(entries) == 0 {
+					return m, tea.Batch(cmds...)
+				}
+				entry := entries[rand.IntN(len(entries))]
+				lastSelectedMediaID = entry.Media.ID
+				if filterState != list.FilterApplied {
+					selectMediaID(&currentList, entry.Media.ID)
+					m.lists[currentStatus] = currentList
+				}
+				return m, tea.Batch(append(cmds, func() tea.Msg {
+					return AnimeSelectedMsg{
+						Anime:             entry.Media,
+						Entry:             &entry,
+						ShowEpisodeSelect: true,
+					}
+				})...)
+
+			case " ":
+				// Mark or unmark the highlighted show for a batch status change
+				if item, ok := currentList.SelectedItem().(AnimeItem); ok {
+					id := item.Entry.Media.ID
+					if m.selected[id] {
+						delete(m.selected, id)
+					} else {
+						m.selected[id] = true
+					}
+					item.Selected = m.selected[id]
+					cmds = append(cmds, currentList.SetItem(currentList.GlobalIndex(), item))
+					currentList.CursorDown()
+					m.lists[currentStatus] = currentList
+				}
+				return m, tea.Batch(cmds...)
+
+			case "*":
+				// Add the highlighted show to AniList favourites, or take it off
+				if item, ok := currentList.SelectedItem().(AnimeItem); ok && m.client != nil {
+					return m, tea.Batch(append(cmds, m.toggleFavourite(item.Entry.Media))...)
+				}
+				return m, tea.Batch(cmds...)
+
+			case "e":
+				// Write a reminder on the highlighted entry, e.g. where a rewatch should pick up
+				if item, ok := currentList.SelectedItem().(AnimeItem); ok && m.client != nil {
+					m.openNotes(item.Entry)
+					return m, tea.Batch(append(cmds, textinput.Blink)...)
+				}
+				return m, tea.Batch(cmds...)
+
+			case "m":
+				// Pick a status for all marked shows
+				if len(m.selected) > 0 && m.client != nil {
+					m.state = ListBatchStatus
+					m.batchCursor = min(m.tabIndex, len(listStatuses)-1)
+				}
+				return m, tea.Batch(cmds...)
+
 			case "n", "s":
 				// Start search
 				m.state = ListSearchInput
@@ -789,6 +1251,7 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				switch msg.String() {
 				case "enter":
 					// Auto-play next episode
+					lastSelectedMediaID = animeItem.Entry.Media.ID
 					return m, func() tea.Msg {
 						return AnimeSelectedMsg{
 							Anime:            animeItem.Entry.Media,
@@ -796,8 +1259,30 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							ShowEpisodeSelect: false,
 						}
 					}
+				case "v":
+					// Jump to sequels, prequels and side stories
+					lastSelectedMediaID = animeItem.Entry.Media.ID
+					return m, func() tea.Msg {
+						return RelationsRequestMsg{Anime: animeItem.Entry.Media}
+					}
+				case "t":
+					// Watch the trailer before committing to a show
+					return m, func() tea.Msg {
+						return TrailerRequestMsg{Anime: animeItem.Entry.Media}
+					}
+				case "w":
+					// Play the episode after the saved progress, even for completed shows
+					lastSelectedMediaID = animeItem.Entry.Media.ID
+					return m, func() tea.Msg {
+						return AnimeSelectedMsg{
+							Anime:    animeItem.Entry.Media,
+							Entry:    &animeItem.Entry,
+							PlayNext: true,
+						}
+					}
 				case "p":
 					// Show episode selection
+					lastSelectedMediaID = animeItem.Entry.Media.ID
 					return m, func() tea.Msg {
 						return AnimeSelectedMsg{
 							Anime:            animeItem.Entry.Media,
@@ -808,14 +1293,69 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 
-		case ListSearchInput:
-			// Handle universal keys in search input (but only quit, not help)
-			if key.Matches(msg, m.universalKeys.Quit) {
-				return m, func() tea.Msg { return BackMsg{} }
+		case ListBatchStatus:
+			switch msg.String() {
+			case "up", "k":
+				if m.batchCursor > 0 {
+					m.batchCursor--
+				}
+			case "down", "j":
+				if m.batchCursor < len(listStatuses)-1 {
+					m.batchCursor++
+				}
+			case "esc", "q":
+				m.state = ListResults
+			case "enter":
+				m.batchStatus = m.statuses[m.batchCursor]
+				m.batchQueue = m.markedEntries(m.batchStatus)
+				if len(m.batchQueue) == 0 {
+					m.state = ListResults
+					label := m.statusLabels[m.batchCursor]
+					return m, func() tea.Msg {
+						return ToastMsg{Text: "Marked shows are already in " + label, Kind: ToastError}
+					}
+				}
+				logger.Info("Starting batch status change", map[string]interface{}{
+					"status": m.batchStatus,
+					"count":  len(m.batchQueue),
+				})
+				m.batchDone = 0
+				m.batchFailed = 0
+				m.state = ListBatchUpdating
+				return m, m.batchUpdateStep(m.batchQueue[0], 0)
 			}
-			
+			return m, nil
+
+		case ListBatchUpdating:
+			// Wait for the batch to finish; ctrl+c still quits from the app
+			return m, nil
+
+		case ListNotesEdit:
+			// Everything else is typed into the notes, so only esc and enter act
 			switch msg.String() {
-			case "ctrl+c", "esc", "q":
+			case "esc":
+				m.notesInput.Blur()
+				m.state = ListResults
+				return m, nil
+			case "enter":
+				m.notesInput.Blur()
+				m.state = ListResults
+				return m, m.saveNotes(m.notesEntry.Media, strings.TrimSpace(m.notesInput.Value()))
+			}
+			m.notesInput, cmd = m.notesInput.Update(msg)
+			return m, cmd
+
+		case ListSearchLoading:
+			// Give up on a slow search; its result is dropped when it arrives
+			if msg.String() == "esc" {
+				m.state = ListSearchInput
+			}
+			return m, nil
+
+		case ListSearchInput:
+			// Letters are typed into the query, so only esc leaves (ctrl+c quits from the app)
+			switch msg.String() {
+			case "esc":
 				m.state = ListResults
 				m.searchInput = ""
 				m.searchResults = []anilist.Anime{}
@@ -835,20 +1375,18 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			default:
-				// Only add printable characters (ignore special keys)
-				if len(msg.Runes) > 0 {
-					m.searchInput += string(msg.Runes)
-				}
+				m.searchInput += typedText(msg)
 				return m, nil
 			}
 
 		case ListSearchResults:
 			// Always update the list first to handle filter state changes
+			before := m.searchList.FilterState()
 			m.searchList, cmd = m.searchList.Update(msg)
 			cmds = append(cmds, cmd)
-			
-			// If we're actively filtering, don't handle other keys
-			if m.searchList.FilterState() == list.Filtering {
+
+			// Keys that opened, edited, confirmed or cleared the filter are done
+			if classifyFilterKey(before, m.searchList.FilterState(), msg.String()) != filterKeyPassThrough {
 				return m, tea.Batch(cmds...)
 			}
 			
@@ -883,6 +1421,24 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							ShowEpisodeSelect: true,
 						}
 					}
+				case "w":
+					// Pick up after the progress on the user's list, if the show is on one
+					entry := m.entryFor(searchItem.Anime.ID)
+					return m, func() tea.Msg {
+						return AnimeSelectedMsg{
+							Anime:    searchItem.Anime,
+							Entry:    entry,
+							PlayNext: true,
+						}
+					}
+				case "a":
+					// Save for later without watching, unless it's already on one of the lists
+					if label := m.listLabelFor(searchItem.Anime.ID); label != "" {
+						return m, func() tea.Msg {
+							return ToastMsg{Text: fmt.Sprintf("%s is already in %s", searchItem.Anime.Title.UserPreferred, label), Kind: ToastError}
+						}
+					}
+					return m, addToPlanning(m.cfg, m.client, searchItem.Anime)
 				}
 			}
 		}
@@ -916,9 +1472,73 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.searchList.Title = "" // No title, we show it in the UI
 		}
 
+	case AddToPlanningResultMsg:
+		return m, planningResultToast(msg)
+
+	case FavouriteToggledMsg:
+		if msg.Err != nil {
+			return m, func() tea.Msg {
+				return ToastMsg{Text: fmt.Sprintf("Couldn't update favourites: %v", msg.Err), Kind: ToastError}
+			}
+		}
+		// The show may sit on any tab; patch the cache too so the star survives reopening the list
+		for _, entries := range []map[string][]anilist.MediaListEntry{m.entries, animeListCache} {
+			for status := range entries {
+				for i := range entries[status] {
+					if entries[status][i].Media.ID == msg.Anime.ID {
+						entries[status][i].Media.IsFavourite = msg.Favourite
+					}
+				}
+			}
+		}
+		saveCacheToDisk()
+		m.lastCacheTimestamp = cacheTimestamp
+		m.updateListsForAllStatuses()
+		text := fmt.Sprintf("Added %s to favourites", msg.Anime.Title.UserPreferred)
+		if !msg.Favourite {
+			text = fmt.Sprintf("Removed %s from favourites", msg.Anime.Title.UserPreferred)
+		}
+		return m, func() tea.Msg { return ToastMsg{Text: text, Kind: ToastSuccess} }
+
+	case NotesSavedMsg:
+		if msg.Err != nil {
+			return m, func() tea.Msg {
+				return ToastMsg{Text: fmt.Sprintf("Couldn't save notes: %v", msg.Err), Kind: ToastError}
+			}
+		}
+		// Patch the cache too so the notes are there next time the list opens
+		for _, entries := range []map[string][]anilist.MediaListEntry{m.entries, animeListCache} {
+			for status := range entries {
+				for i := range entries[status] {
+					if entries[status][i].Media.ID == msg.Anime.ID {
+						entries[status][i].Notes = msg.Notes
+					}
+				}
+			}
+		}
+		saveCacheToDisk()
+		m.lastCacheTimestamp = cacheTimestamp
+		m.updateListsForAllStatuses()
+		text := fmt.Sprintf("Saved notes for %s", msg.Anime.Title.UserPreferred)
+		if msg.Notes == "" {
+			text = fmt.Sprintf("Cleared notes for %s", msg.Anime.Title.UserPreferred)
+		}
+		return m, func() tea.Msg { return ToastMsg{Text: text, Kind: ToastSuccess} }
+
+	case BatchStatusStepMsg:
+		if msg.Err != nil {
+			m.batchFailed++
+		}
+		m.batchDone++
+		if m.batchDone < len(m.batchQueue) {
+			return m, m.batchUpdateStep(m.batchQueue[m.batchDone], batchUpdateDelay)
+		}
+		return m, m.finishBatch()
+
 	case AllListsResultMsg:
-		// Only change state if we're not in search mode
-		if m.state != ListSearchInput && m.state != ListSearchLoading && m.state != ListSearchResults {
+		// Only change state if we're not in search mode, a batch change or editing notes
+		if m.state != ListSearchInput && m.state != ListSearchLoading && m.state != ListSearchResults &&
+			m.state != ListBatchStatus && m.state != ListBatchUpdating && m.state != ListNotesEdit {
 			m.state = ListResults
 		}
 		
@@ -947,10 +1567,17 @@ func (m *AnimeList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		
 		m.isRefreshing = false
-		
-		// Only reset tab if not a background refresh
-		if !msg.IsRefresh {
-			m.tabIndex = 0
+
+		// Some tabs are showing cached data; say so unless this was a silent background refresh
+		if msg.PartialErr != nil {
+			logger.Warn("Some anime lists failed to load", map[string]interface{}{
+				"error": msg.PartialErr.Error(),
+			})
+			if !msg.IsRefresh {
+				cmds = append(cmds, func() tea.Msg {
+					return ToastMsg{Text: "Some lists couldn't be refreshed - showing cached data", Kind: ToastError}
+				})
+			}
 		}
 	}
 
@@ -984,6 +1611,9 @@ func (m *AnimeList) View() string {
 	if m.state == ListSearchLoading {
 		s := m.styles.Title.Render("Searching...") + "\n\n"
 		s += fmt.Sprintf("%s %s\n", m.spinner.View(), m.styles.Info.Render(fmt.Sprintf("Searching for: %s", m.searchInput)))
+		s += m.help.View(backOnlyKeyMap{
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		})
 		return s
 	}
 
@@ -999,7 +1629,7 @@ func (m *AnimeList) View() string {
 		}
 		
 		if m.err != nil {
-			s := m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+			s := renderError(m.styles, m.err) + "\n"
 			s += m.help.View(backHelpKeys)
 			return s
 		} else if len(m.searchResults) == 0 {
@@ -1024,6 +1654,8 @@ func (m *AnimeList) View() string {
 				key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
 				key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "auto-play")),
 				key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "select episode")),
+				key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "play next unwatched")),
+				key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add to planning")),
 				key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
 			},
 			ViewFull: [][]key.Binding{
@@ -1031,6 +1663,8 @@ func (m *AnimeList) View() string {
 				 key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down"))},
 				{key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "auto-play")),
 				 key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "select episode")),
+				 key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "play next unwatched")),
+				 key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add to planning")),
 				 key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back"))},
 			},
 		}
@@ -1038,6 +1672,50 @@ func (m *AnimeList) View() string {
 		return s
 	}
 
+	if m.state == ListBatchStatus {
+		s := m.styles.Title.Render(fmt.Sprintf("Move %d Marked Shows To", len(m.selected))) + "\n\n"
+		for i, label := range listStatusLabels {
+			if i == m.batchCursor {
+				s += m.styles.SelectedItem.Render("> "+label) + "\n"
+			} else {
+				s += m.styles.MenuItem.Render("  "+label) + "\n"
+			}
+		}
+		helpKeys := ExtendedKeyMap{
+			Universal: m.universalKeys,
+			ViewKeys: []key.Binding{
+				m.keys.Up, m.keys.Down,
+				key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "move")),
+				key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+			},
+			ViewFull: [][]key.Binding{
+				{m.keys.Up, m.keys.Down},
+				{key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "move")),
+				 key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel"))},
+			},
+		}
+		s += "\n" + m.help.View(helpKeys)
+		return s
+	}
+
+	if m.state == ListNotesEdit {
+		s := m.styles.Title.Render("Notes for "+m.notesEntry.Media.Title.UserPreferred) + "\n\n"
+		s += m.notesInput.View() + "\n\n"
+		s += m.styles.Info.Render("Leave empty to clear the notes.") + "\n\n"
+		s += m.help.View(episodeOffsetKeyMap{
+			Save: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		})
+		return s
+	}
+
+	if m.state == ListBatchUpdating {
+		label := m.statusLabels[m.getStatusIndex(m.batchStatus)]
+		s := m.styles.Title.Render("Moving to "+label) + "\n\n"
+		s += fmt.Sprintf("%s %s\n", m.spinner.View(), m.styles.Info.Render(fmt.Sprintf("Updated %d of %d shows...", m.batchDone, len(m.batchQueue))))
+		return s
+	}
+
 	if m.state == ListLoading && !m.cacheLoaded {
 		// Only show loading screen if no cache available
 		s := m.styles.Title.Render("Loading Anime Lists") + "\n\n"
@@ -1046,7 +1724,7 @@ func (m *AnimeList) View() string {
 	}
 
 	if m.err != nil {
-		s := m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+		s := renderError(m.styles, m.err) + "\n"
 		helpKeys := ExtendedKeyMap{
 			Universal: m.universalKeys,
 			ViewKeys: []key.Binding{
@@ -1089,6 +1767,9 @@ func (m *AnimeList) View() string {
 	}
 
 	tabBar := lipgloss.JoinHorizontal(lipgloss.Top, tabs...)
+	if len(m.selected) > 0 {
+		tabBar += m.styles.Info.Render(fmt.Sprintf("  %d marked", len(m.selected)))
+	}
 	s := tabBar + "\n"
 
 	// Get current tab's list
@@ -1098,6 +1779,9 @@ func (m *AnimeList) View() string {
 	// Update list height to use full available space
 	// Reserve: 1 line for tabs, 2 lines for list title
 	listHeight := m.height - 3
+	if m.isRefreshing {
+		listHeight-- // Room for the refresh status line
+	}
 	if listHeight < 5 {
 		listHeight = 5 // Minimum height
 	}
@@ -1128,17 +1812,22 @@ func (m *AnimeList) View() string {
 		Universal: m.universalKeys,
 		ViewKeys: []key.Binding{
 			m.keys.Left, m.keys.Right, m.keys.Up, m.keys.Down,
-			m.keys.Select, m.keys.SelectEpisode, m.keys.Search, m.keys.Refresh,
+			m.keys.Select, m.keys.SelectEpisode, m.keys.PlayNext, m.keys.Search, m.keys.Refresh,
 		},
 		ViewFull: [][]key.Binding{
 			{m.keys.Left, m.keys.Right, m.keys.Up, m.keys.Down},
-			{m.keys.Select, m.keys.SelectEpisode, m.keys.Search, m.keys.Refresh},
+			{m.keys.Select, m.keys.SelectEpisode, m.keys.PlayNext, m.keys.Search, m.keys.Refresh, m.keys.HardRefresh, m.keys.Random, m.keys.Trailer, m.keys.Related},
+			{m.keys.Mark, m.keys.Batch, m.keys.Favourite, m.keys.Notes},
 		},
 	}
 	helpView := m.help.View(helpKeys)
 	if m.isRefreshing {
-		// Add spinner before help
-		helpView = m.spinner.View() + " " + helpView
+		// Say how old the data on screen is while the refresh runs
+		status := "refreshing…"
+		if !cacheTimestamp.IsZero() {
+			status = fmt.Sprintf("list last updated %s — refreshing…", utils.FormatAgo(cacheTimestamp))
+		}
+		s += "\n" + m.spinner.View() + " " + m.styles.Info.Render(status)
 	}
 	s += "\n" + helpView
 	
@@ -2,7 +2,11 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -11,6 +15,9 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pranshuj73/oni/anilist"
 	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/providers"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // AnimeSearchState represents the search state
@@ -22,6 +29,53 @@ const (
 	SearchLoading
 )
 
+// searchTimeout bounds a search so a slow AniList or provider can't leave the spinner running forever
+const searchTimeout = 20 * time.Second
+
+// errSearchTimedOut replaces the deadline error with something the user can act on
+var errSearchTimedOut = errors.New("search timed out, press esc to go back and try again")
+
+// searchError maps a search that hit searchTimeout to errSearchTimedOut
+func searchError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errSearchTimedOut
+	}
+	return err
+}
+
+// titleFields are the prefixes that target one title script, e.g. "native:進撃の巨人"
+var titleFields = []string{"romaji", "english", "native"}
+
+// parseSearchQuery splits a title prefix off a query; field is "" when there is none
+func parseSearchQuery(input string) (field string, term string) {
+	for _, name := range titleFields {
+		if rest, ok := strings.CutPrefix(input, name+":"); ok {
+			return name, strings.TrimSpace(rest)
+		}
+	}
+	return "", strings.TrimSpace(input)
+}
+
+// searchAniList searches AniList, listing shows whose targeted title contains the term first
+// AniList matches every script anyway, so a prefix reorders rather than filters
+func searchAniList(client *anilist.Client, input string, showAdult bool) ([]anilist.Anime, error) {
+	field, term := parseSearchQuery(input)
+	ctx, cancel := context.WithTimeout(context.Background(), searchTimeout)
+	defer cancel()
+	results, err := client.SearchAnime(ctx, term, showAdult)
+	if err != nil || field == "" {
+		return results, searchError(err)
+	}
+
+	needle := strings.ToLower(term)
+	sort.SliceStable(results, func(i, j int) bool {
+		iMatch := strings.Contains(strings.ToLower(results[i].Title.Field(field)), needle)
+		jMatch := strings.Contains(strings.ToLower(results[j].Title.Field(field)), needle)
+		return iMatch && !jMatch
+	})
+	return results, nil
+}
+
 // searchInputKeyMap for search input help
 type searchInputHelpKeyMap struct {
 	Enter key.Binding
@@ -42,16 +96,18 @@ type searchResultsHelpKeyMap struct {
 	Down          key.Binding
 	Select        key.Binding
 	SelectEpisode key.Binding
+	Plan          key.Binding
+	Adult         key.Binding
 	Back          key.Binding
 	Quit          key.Binding
 }
 
 func (k searchResultsHelpKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Up, k.Down, k.Select, k.SelectEpisode, k.Back, k.Quit}
+	return []key.Binding{k.Up, k.Down, k.Select, k.SelectEpisode, k.Plan, k.Adult, k.Back, k.Quit}
 }
 
 func (k searchResultsHelpKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{{k.Up, k.Down, k.Select, k.SelectEpisode, k.Back, k.Quit}}
+	return [][]key.Binding{{k.Up, k.Down, k.Select, k.SelectEpisode, k.Plan, k.Adult, k.Back, k.Quit}}
 }
 
 // backOnlyHelpKeyMap for back only help
@@ -79,6 +135,11 @@ type AnimeSearch struct {
 	err     error
 	spinner spinner.Model
 	help    help.Model
+	// Local search (no AniList) fields
+	localOnly  bool
+	localShows map[int]providers.SearchResult
+	// Runtime adult content toggle (not persisted)
+	showAdult bool
 }
 
 // NewAnimeSearch creates a new anime search
@@ -100,6 +161,11 @@ func NewAnimeSearch(cfg *config.Config, client *anilist.Client) *AnimeSearch {
 		results: []anilist.Anime{},
 		spinner: s,
 		help:    h,
+		// Without AniList, search the provider's own catalogue instead
+		localOnly:  cfg.AniList.NoAniList || client == nil,
+		localShows: make(map[int]providers.SearchResult),
+		// Starts from the config; x flips it for this search only
+		showAdult: cfg.Advanced.ShowAdultContent,
 	}
 }
 
@@ -114,19 +180,76 @@ type SearchResultMsg struct {
 	Err     error
 }
 
+// LocalSearchResultMsg is sent when a provider search (used without AniList) completes
+type LocalSearchResultMsg struct {
+	Results []providers.SearchResult
+	Err     error
+}
+
 // AnimeSelectedMsg is sent when an anime is selected
 type AnimeSelectedMsg struct {
 	Anime            anilist.Anime
 	Entry            *anilist.MediaListEntry // Optional: entry from user's list with progress
 	ShowEpisodeSelect bool                    // If true, show episode selection; if false, auto-play
+	PlayNext          bool                    // If true, play the episode after the entry's progress, starting over once all are watched
 }
 
 // searchAnime performs the search
 func (m *AnimeSearch) searchAnime() tea.Msg {
-	results, err := m.client.SearchAnime(context.Background(), m.input, m.cfg.Advanced.ShowAdultContent)
+	if m.localOnly {
+		return m.searchProvider()
+	}
+	results, err := searchAniList(m.client, m.input, m.showAdult)
 	return SearchResultMsg{Results: results, Err: err}
 }
 
+// searchProvider searches the configured provider directly
+func (m *AnimeSearch) searchProvider() tea.Msg {
+	provider, err := providers.GetProvider(m.cfg.Provider.Provider)
+	if err != nil {
+		return LocalSearchResultMsg{Err: err}
+	}
+
+	searcher, ok := provider.(providers.Searcher)
+	if !ok {
+		return LocalSearchResultMsg{Err: fmt.Errorf("%s: %w", provider.Name(), providers.ErrSearchUnsupported)}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), searchTimeout)
+	defer cancel()
+	// Providers have a single title, so a script prefix is dropped
+	_, term := parseSearchQuery(m.input)
+	results, err := searcher.Search(ctx, term, m.cfg.Playback.SubOrDub)
+	if err != nil {
+		logger.Error("Provider search failed", err, map[string]interface{}{
+			"provider": m.cfg.Provider.Provider,
+			"query":    m.input,
+		})
+	}
+	return LocalSearchResultMsg{Results: results, Err: searchError(err)}
+}
+
+// selectAnime returns the command that opens the anime at the cursor
+// Provider results are mapped to their show first so playback skips the title lookup
+func (m *AnimeSearch) selectAnime(showEpisodeSelect bool) tea.Cmd {
+	anime := m.results[m.cursor]
+	if show, ok := m.localShows[anime.ID]; ok {
+		if err := providers.SaveProviderMapping(m.cfg.Provider.Provider, show.MediaID, show.ShowID, show.Title); err != nil {
+			logger.Warn("Failed to save provider mapping", map[string]interface{}{
+				"provider": m.cfg.Provider.Provider,
+				"showID":   show.ShowID,
+				"error":    err.Error(),
+			})
+		}
+	}
+	return func() tea.Msg {
+		return AnimeSelectedMsg{
+			Anime:             anime,
+			ShowEpisodeSelect: showEpisodeSelect,
+		}
+	}
+}
+
 // Update handles messages
 func (m *AnimeSearch) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -138,8 +261,9 @@ func (m *AnimeSearch) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch m.state {
 		case SearchInput:
+			// Letters are typed into the query, so only esc leaves (ctrl+c quits from the app)
 			switch msg.String() {
-			case "ctrl+c", "esc", "q":
+			case "esc":
 				return m, func() tea.Msg { return BackMsg{} }
 
 			case "backspace":
@@ -160,13 +284,17 @@ func (m *AnimeSearch) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			default:
-				// Only add printable characters (ignore special keys)
-				if len(msg.Runes) > 0 {
-					m.input += string(msg.Runes)
-				}
+				m.input += typedText(msg)
 				return m, nil
 			}
 
+		case SearchLoading:
+			// Give up on a slow search; its result is dropped when it arrives
+			if msg.String() == "esc" {
+				m.state = SearchInput
+			}
+			return m, nil
+
 		case SearchResults:
 			switch msg.String() {
 			case "ctrl+c", "esc", "q", "backspace":
@@ -184,33 +312,65 @@ func (m *AnimeSearch) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case "enter":
 				if len(m.results) > 0 {
-					return m, func() tea.Msg {
-						return AnimeSelectedMsg{
-							Anime:            m.results[m.cursor],
-							ShowEpisodeSelect: false, // Auto-play (but will show episode select if no progress)
-						}
-					}
+					// Auto-play (but will show episode select if no progress)
+					return m, m.selectAnime(false)
 				}
 
 			case "p":
 				// Select anime and show episode selection
 				if len(m.results) > 0 {
-					return m, func() tea.Msg {
-						return AnimeSelectedMsg{
-							Anime:            m.results[m.cursor],
-							ShowEpisodeSelect: true, // Show episode selection
-						}
-					}
+					return m, m.selectAnime(true)
+				}
+
+			case "a":
+				// Save for later without watching; provider-only results have no AniList entry
+				if len(m.results) > 0 && !m.localOnly {
+					return m, addToPlanning(m.cfg, m.client, m.results[m.cursor])
 				}
 
+			case "x":
+				// Reveal or hide adult results for this session and search again; provider searches can't filter them
+				if !m.localOnly {
+					m.showAdult = !m.showAdult
+					m.state = SearchLoading
+					return m, m.searchAnime
+				}
 			}
 		}
 
+	case AddToPlanningResultMsg:
+		return m, planningResultToast(msg)
+
 	case SearchResultMsg:
+		if m.state != SearchLoading {
+			return m, nil
+		}
 		m.state = SearchResults
 		m.results = msg.Results
 		m.err = msg.Err
 		m.cursor = 0
+
+	case LocalSearchResultMsg:
+		if m.state != SearchLoading {
+			return m, nil
+		}
+		m.state = SearchResults
+		m.results = make([]anilist.Anime, 0, len(msg.Results))
+		m.localShows = make(map[int]providers.SearchResult, len(msg.Results))
+		for _, result := range msg.Results {
+			anime := anilist.Anime{
+				ID:    result.MediaID,
+				Title: anilist.Title{UserPreferred: result.Title, Romaji: result.Title},
+			}
+			if result.Episodes > 0 {
+				episodes := result.Episodes
+				anime.Episodes = &episodes
+			}
+			m.results = append(m.results, anime)
+			m.localShows[result.MediaID] = result
+		}
+		m.err = msg.Err
+		m.cursor = 0
 	}
 
 	return m, nil
@@ -221,6 +381,12 @@ func (m *AnimeSearch) View() string {
 	switch m.state {
 	case SearchInput:
 		s := m.styles.Title.Render("Search Anime") + "\n\n"
+		if m.localOnly {
+			s += m.styles.Info.Render(fmt.Sprintf("AniList is off - searching %s directly", m.cfg.Provider.Provider)) + "\n\n"
+		}
+		if m.showAdult && !m.localOnly {
+			s += m.styles.Info.Render("Adult content shown") + "\n\n"
+		}
 		s += m.styles.Prompt.Render("Enter anime name:") + "\n"
 		s += m.styles.MenuItem.Render(m.input + "█") + "\n\n"
 		keys := searchInputHelpKeyMap{
@@ -233,17 +399,23 @@ func (m *AnimeSearch) View() string {
 	case SearchLoading:
 		s := m.styles.Title.Render("Search Anime") + "\n\n"
 		s += fmt.Sprintf("%s %s\n", m.spinner.View(), m.styles.Info.Render("Searching..."))
+		s += m.help.View(backOnlyHelpKeyMap{
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		})
 		return s
 
 	case SearchResults:
 		s := m.styles.Title.Render("Search Results") + "\n\n"
+		if m.showAdult && !m.localOnly {
+			s += m.styles.Info.Render("Adult content shown") + "\n\n"
+		}
 
 		backKeys := backOnlyHelpKeyMap{
 			Back: key.NewBinding(key.WithKeys("backspace"), key.WithHelp("backspace", "back")),
 		}
 
 		if m.err != nil {
-			s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n"
+			s += renderError(m.styles, m.err)
 			s += m.help.View(backKeys)
 			return s
 		}
@@ -260,7 +432,7 @@ func (m *AnimeSearch) View() string {
 
 			// Add episode count if available
 			if anime.Episodes != nil {
-				title = fmt.Sprintf("%s (%d episodes)", title, *anime.Episodes)
+				title = fmt.Sprintf("%s (%d episodes, %s)", title, *anime.Episodes, utils.FormatWatchTime(anime.WatchMinutes()))
 			}
 
 			// Add start year if available
@@ -268,6 +440,11 @@ func (m *AnimeSearch) View() string {
 				title = fmt.Sprintf("%s [%d]", title, *anime.StartDate.Year)
 			}
 
+			// Flag dubbed shows when the provider reports it
+			if show, ok := m.localShows[anime.ID]; ok && show.Availability.HasDub() {
+				title += " • dub"
+			}
+
 			if m.cursor == i {
 				cursor = ">"
 				s += m.styles.SelectedItem.Render(cursor + " " + title) + "\n"
@@ -281,9 +458,13 @@ func (m *AnimeSearch) View() string {
 			Down:          key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
 			Select:        key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "auto-play")),
 			SelectEpisode: key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "select episode")),
+			Plan:          key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add to planning")),
+			Adult:         key.NewBinding(key.WithKeys("x"), key.WithHelp("x", adultToggleHelp(m.showAdult))),
 			Back:          key.NewBinding(key.WithKeys("backspace"), key.WithHelp("backspace", "back")),
 			Quit:          key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "quit")),
 		}
+		keys.Plan.SetEnabled(!m.localOnly)
+		keys.Adult.SetEnabled(!m.localOnly)
 		s += "\n" + m.help.View(keys)
 		return s
 	}
@@ -291,6 +472,14 @@ func (m *AnimeSearch) View() string {
 	return ""
 }
 
+// adultToggleHelp describes what the adult content toggle will do
+func adultToggleHelp(showAdult bool) string {
+	if showAdult {
+		return "hide adult"
+	}
+	return "show adult"
+}
+
 // BackMsg is sent when the user wants to go back
 type BackMsg struct{}
 
@@ -2,14 +2,21 @@ package ui
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/pranshuj73/oni/anilist"
 	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/player"
+	"github.com/pranshuj73/oni/utils"
 )
 
 // ConfigEditorState represents the config editor state
@@ -22,8 +29,17 @@ const (
 	ConfigSelectEdit
 	ConfigSaving
 	ConfigSaved
+	ConfigLogView
+	ConfigClearHistoryConfirm
+	ConfigResetConfirm
 )
 
+// logTailLines is the number of log lines shown in the log viewer
+const logTailLines = 200
+
+// clearHistoryOptions are the choices shown when confirming a history wipe
+var clearHistoryOptions = []string{"Cancel", "Clear watch history", "Clear watch and incognito history"}
+
 // ConfigEditor represents the config editor model
 type ConfigEditor struct {
 	cfg                *config.Config
@@ -39,6 +55,10 @@ type ConfigEditor struct {
 	help               help.Model
 	universalKeys       UniversalKeys
 	prevIncognitoState bool // Track previous incognito state to detect toggle off
+	logViewport        viewport.Model
+	confirmCursor      int
+	width              int
+	height             int
 }
 
 // ConfigItem represents a configuration item
@@ -58,21 +78,50 @@ const (
 	ConfigTypeText ConfigItemType = iota
 	ConfigTypeToggle
 	ConfigTypeSelect
+	ConfigTypeAction
 )
 
-// NewConfigEditor creates a new config editor
-func NewConfigEditor(cfg *config.Config) *ConfigEditor {
-	items := []ConfigItem{
+// configItemsFor builds the editable items from a config
+func configItemsFor(cfg *config.Config) []ConfigItem {
+	return []ConfigItem{
 		{"player", "Player", cfg.Player.Player, ConfigTypeText, "Player", nil},
 		{"player_arguments", "Player Arguments", cfg.Player.PlayerArguments, ConfigTypeText, "Player", nil},
-		{"provider", "Provider", cfg.Provider.Provider, ConfigTypeSelect, "Provider", []string{"allanime", "aniwatch", "yugen", "hdrezka", "aniworld"}},
-		{"quality", "Quality", cfg.Provider.Quality, ConfigTypeSelect, "Provider", []string{"1080", "720", "480", "360", "240", "best", "worst"}},
+		{"subtitle_font_size", "Subtitle Font Size", cfg.Player.SubtitleFontSize, ConfigTypeText, "Player", nil},
+		{"subtitle_color", "Subtitle Color", cfg.Player.SubtitleColor, ConfigTypeText, "Player", nil},
+		{"provider", "Provider", cfg.Provider.Provider, ConfigTypeSelect, "Provider", []string{"allanime", "aniwatch", "yugen", "hdrezka", "aniworld", "gogoanime", "animepahe"}},
+		{"quality", "Quality", cfg.Provider.Quality, ConfigTypeSelect, "Provider", []string{"1080", "720", "480", "360", "240", "best", "worst", "ask"}},
+		{"provider_quality", "Quality for Current Provider", providerQualityValue(cfg), ConfigTypeSelect, "Provider", []string{"default", "1080", "720", "480", "360", "240", "best", "worst", "ask"}},
 		{"sub_or_dub", "Sub or Dub", cfg.Playback.SubOrDub, ConfigTypeSelect, "Playback", []string{"sub", "dub"}},
 		{"subs_language", "Subtitles Language", cfg.Playback.SubsLanguage, ConfigTypeText, "Playback", nil},
 		{"persist_incognito_sessions", "Persist Incognito Sessions", cfg.Playback.PersistIncognitoSessions, ConfigTypeToggle, "Playback", nil},
+		{"completion_threshold", "Count as Watched At (%)", cfg.Playback.CompletionThreshold, ConfigTypeText, "Playback", nil},
+		{"next_episode_threshold", "Continue With Next Episode At (%)", cfg.Playback.NextEpisodeThreshold, ConfigTypeText, "Playback", nil},
+		{"resume_autoplay", "Offer to Resume Autoplay on Startup", cfg.Playback.ResumeAutoplay, ConfigTypeToggle, "Playback", nil},
+		{"restore_session", "Restore Last Screen on Startup", cfg.UI.RestoreSession, ConfigTypeToggle, "UI", nil},
+		{"launch_action", "On Startup", cfg.UI.LaunchAction, ConfigTypeSelect, "UI", []string{"menu", "continue"}},
+		{"menu_items", "Main Menu Items", cfg.UI.MenuItems, ConfigTypeText, "UI", nil},
 		{"discord_presence", "Discord Presence", cfg.Discord.DiscordPresence, ConfigTypeToggle, "Discord", nil},
+		{"discord_app_id", "Discord App ID", cfg.Discord.AppID, ConfigTypeText, "Discord", nil},
+		{"discord_details_template", "Details Template", cfg.Discord.DetailsTemplate, ConfigTypeText, "Discord", nil},
+		{"discord_state_template", "State Template", cfg.Discord.StateTemplate, ConfigTypeText, "Discord", nil},
+		{"discord_small_image", "Small Image", cfg.Discord.SmallImage, ConfigTypeText, "Discord", nil},
+		{"discord_small_text", "Small Image Text", cfg.Discord.SmallText, ConfigTypeText, "Discord", nil},
+		{"token_storage", "AniList Token Storage", cfg.AniList.TokenStorage, ConfigTypeSelect, "AniList", []string{"file", "keyring"}},
+		{"private_incognito_updates", "Private Updates in Incognito", cfg.AniList.PrivateIncognitoUpdates, ConfigTypeToggle, "AniList", nil},
+		{"auto_add_on_play", "Add Unlisted Shows to Watching on Play", cfg.AniList.AutoAddOnPlay, ConfigTypeToggle, "AniList", nil},
+		{"cache_refresh_minutes", "Refresh Cached Lists After (minutes)", cfg.AniList.CacheRefreshMinutes, ConfigTypeText, "AniList", nil},
+		{"reauth_anilist", "Re-authenticate AniList", nil, ConfigTypeAction, "AniList", nil},
 		{"show_adult_content", "Show Adult Content", cfg.Advanced.ShowAdultContent, ConfigTypeToggle, "Advanced", nil},
+		{"log_level", "Log Level", cfg.Advanced.LogLevel, ConfigTypeSelect, "Advanced", []string{"debug", "info", "warn", "error"}},
+		{"data_dir", "Data Directory", cfg.Advanced.DataDir, ConfigTypeText, "Advanced", nil},
+		{"clear_history", "Clear Watch History", nil, ConfigTypeAction, "Advanced", nil},
+		{"view_logs", "Open Log File", nil, ConfigTypeAction, "Advanced", nil},
 	}
+}
+
+// NewConfigEditor creates a new config editor
+func NewConfigEditor(cfg *config.Config) *ConfigEditor {
+	items := configItemsFor(cfg)
 
 	ti := textinput.New()
 	ti.Placeholder = "Enter value..."
@@ -87,6 +136,9 @@ func NewConfigEditor(cfg *config.Config) *ConfigEditor {
 		textInput:     ti,
 		help:          help.New(),
 		universalKeys: DefaultUniversalKeys(),
+		logViewport:   viewport.New(80, 16),
+		width:         80,
+		height:        24,
 	}
 	ce.help.ShowAll = false
 	return ce
@@ -102,20 +154,40 @@ type ConfigSavedMsg struct {
 	Err error
 }
 
+// ReauthRequestMsg asks the app to run the AniList authentication flow again
+type ReauthRequestMsg struct{}
+
+// HistoryClearedMsg is sent when watch history has been cleared
+type HistoryClearedMsg struct {
+	Err error
+}
+
 // saveConfig saves the configuration
 func (m *ConfigEditor) saveConfig() tea.Msg {
 	// Note: Incognito mode is now runtime-only (toggled with 'p' key)
 	// We only handle persist_incognito_sessions setting here
+	// Catch typos before they are written and only fail at playback
+	if err := m.cfg.Validate(); err != nil {
+		return ConfigSavedMsg{Err: err}
+	}
 	err := config.Save(m.cfg)
 	return ConfigSavedMsg{Err: err}
 }
 
+// typing reports whether keys are going into the text input or the select list's filter
+func (m *ConfigEditor) typing() bool {
+	return m.state == ConfigTextEdit ||
+		(m.state == ConfigSelectEdit && m.selectList.FilterState() == list.Filtering)
+}
+
 // Update handles messages
 func (m *ConfigEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		// Handle universal keys
+		// Handle universal keys, except while typing so a pasted q or ? lands in the input
+		// ctrl+c still quits from the app
 		switch {
+		case m.typing():
 		case key.Matches(msg, m.universalKeys.Help):
 			m.help.ShowAll = !m.help.ShowAll
 			return m, nil
@@ -169,16 +241,23 @@ func (m *ConfigEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 					m.state = ConfigSelectEdit
 					m.buildSelectList()
+
+				case ConfigTypeAction:
+					return m, m.runAction(item.Name)
 				}
 
 			case "s":
 				m.state = ConfigSaving
 				return m, m.saveConfig
+
+			case "R":
+				m.state = ConfigResetConfirm
+				return m, nil
 			}
 
 		case ConfigTextEdit:
 			switch msg.String() {
-			case "esc", "q", "backspace":
+			case "esc":
 				m.state = ConfigMenuSelection
 				m.textInput.Blur()
 
@@ -256,30 +335,106 @@ func (m *ConfigEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, cmd
 
+		case ConfigLogView:
+			switch msg.String() {
+			case "esc", "q", "backspace":
+				m.state = ConfigMenuSelection
+				return m, nil
+			case "r":
+				m.loadLogTail()
+				return m, nil
+			}
+
+			var cmd tea.Cmd
+			m.logViewport, cmd = m.logViewport.Update(msg)
+			return m, cmd
+
+		case ConfigClearHistoryConfirm:
+			switch msg.String() {
+			case "esc", "q", "backspace", "n":
+				m.state = ConfigMenuSelection
+				return m, nil
+			case "up", "k":
+				if m.confirmCursor > 0 {
+					m.confirmCursor--
+				}
+			case "down", "j":
+				if m.confirmCursor < len(clearHistoryOptions)-1 {
+					m.confirmCursor++
+				}
+			case "enter":
+				m.state = ConfigMenuSelection
+				if m.confirmCursor == 0 {
+					return m, nil
+				}
+				includeIncognito := m.confirmCursor == 2
+				return m, func() tea.Msg {
+					return HistoryClearedMsg{Err: player.ClearHistory(includeIncognito)}
+				}
+			}
+
+		case ConfigResetConfirm:
+			switch msg.String() {
+			case "y", "Y":
+				m.resetToDefaults()
+				m.state = ConfigMenuSelection
+				return m, func() tea.Msg {
+					return ToastMsg{
+						Text: "Defaults restored - press s to save",
+						Kind: ToastSuccess,
+					}
+				}
+			case "n", "N", "esc", "q", "backspace":
+				m.state = ConfigMenuSelection
+				return m, nil
+			}
+
 		case ConfigSaved:
 			switch msg.String() {
 			case "enter", "esc":
+				if m.err != nil {
+					// Go back to the settings so the problems can be fixed
+					m.state = ConfigMenuSelection
+					m.err = nil
+					return m, nil
+				}
 				return m, func() tea.Msg { return BackMsg{} }
 			}
 		}
 
 	case tea.WindowSizeMsg:
 		m.help.Width = msg.Width
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resizeLogViewport()
 		if m.state == ConfigSelectEdit {
 			m.buildSelectList()
 		}
 
-	case ConfigSavedMsg:
-		m.state = ConfigMenuSelection
+	case HistoryClearedMsg:
 		if msg.Err != nil {
-			m.err = msg.Err
 			return m, func() tea.Msg {
 				return ToastMsg{
-					Text: fmt.Sprintf("Error saving settings: %v", msg.Err),
+					Text: fmt.Sprintf("Failed to clear history: %v", msg.Err),
 					Kind: ToastError,
 				}
 			}
 		}
+		return m, func() tea.Msg {
+			return ToastMsg{
+				Text: "Watch history cleared",
+				Kind: ToastSuccess,
+			}
+		}
+
+	case ConfigSavedMsg:
+		if msg.Err != nil {
+			// Show every problem in the saved view instead of a single toast
+			m.state = ConfigSaved
+			m.err = msg.Err
+			return m, nil
+		}
+		m.state = ConfigMenuSelection
 		m.err = nil
 		return m, func() tea.Msg {
 			return ToastMsg{
@@ -292,6 +447,60 @@ func (m *ConfigEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// resetToDefaults restores the default configuration in place and rebuilds the items
+// The AniList token is stored separately and is left untouched
+func (m *ConfigEditor) resetToDefaults() {
+	*m.cfg = *config.Default()
+	m.configItems = configItemsFor(m.cfg)
+	if m.cursor >= len(m.configItems) {
+		m.cursor = len(m.configItems) - 1
+	}
+	if level, err := logger.ParseLevel(m.cfg.Advanced.LogLevel); err == nil {
+		logger.SetMinLevel(level)
+	}
+	utils.SetThresholds(m.cfg.Playback.CompletionThreshold, m.cfg.Playback.NextEpisodeThreshold)
+	anilist.SetKeyringEnabled(m.cfg.AniList.TokenStorage == "keyring")
+	logger.Info("Configuration reset to defaults", nil)
+}
+
+// runAction runs an action-type config item
+func (m *ConfigEditor) runAction(name string) tea.Cmd {
+	switch name {
+	case "view_logs":
+		m.resizeLogViewport()
+		m.loadLogTail()
+		m.state = ConfigLogView
+	case "clear_history":
+		m.confirmCursor = 0
+		m.state = ConfigClearHistoryConfirm
+	case "reauth_anilist":
+		return func() tea.Msg { return ReauthRequestMsg{} }
+	}
+	return nil
+}
+
+// loadLogTail loads the last lines of the log file into the log viewport
+func (m *ConfigEditor) loadLogTail() {
+	lines, err := logger.ReadTail(logTailLines)
+	if err != nil {
+		m.logViewport.SetContent(m.styles.Error.Render(fmt.Sprintf("Error reading log file: %v", err)))
+		return
+	}
+	m.logViewport.SetContent(strings.Join(lines, "\n"))
+	m.logViewport.GotoBottom()
+}
+
+// resizeLogViewport fits the log viewport to the window
+func (m *ConfigEditor) resizeLogViewport() {
+	// Reserve space for title, path and help
+	viewportHeight := m.height - 8
+	if viewportHeight < 5 {
+		viewportHeight = 5
+	}
+	m.logViewport.Width = m.width
+	m.logViewport.Height = viewportHeight
+}
+
 // buildSelectList builds the select list for dropdown
 func (m *ConfigEditor) buildSelectList() {
 	items := make([]list.Item, len(m.selectOptions))
@@ -346,33 +555,129 @@ func (m *ConfigEditor) applyConfigChange(name string, value interface{}) {
 		m.cfg.Player.Player = fmt.Sprintf("%v", value)
 	case "player_arguments":
 		m.cfg.Player.PlayerArguments = fmt.Sprintf("%v", value)
+	case "subtitle_font_size":
+		// Not a number becomes 0, the player's default size
+		size, _ := strconv.Atoi(strings.TrimSpace(fmt.Sprintf("%v", value)))
+		m.cfg.Player.SubtitleFontSize = size
+	case "subtitle_color":
+		m.cfg.Player.SubtitleColor = strings.TrimSpace(fmt.Sprintf("%v", value))
 	case "quality":
 		m.cfg.Provider.Quality = fmt.Sprintf("%v", value)
+		// A quality picked here replaces the one from -q
+		m.cfg.Provider.SessionQuality = ""
 	case "provider":
 		m.cfg.Provider.Provider = fmt.Sprintf("%v", value)
+		// Show the override for the newly selected provider
+		for i := range m.configItems {
+			if m.configItems[i].Name == "provider_quality" {
+				m.configItems[i].Value = providerQualityValue(m.cfg)
+			}
+		}
+	case "provider_quality":
+		m.cfg.Provider.SessionQuality = ""
+		quality := fmt.Sprintf("%v", value)
+		if quality == "default" {
+			delete(m.cfg.Provider.Qualities, m.cfg.Provider.Provider)
+		} else {
+			if m.cfg.Provider.Qualities == nil {
+				m.cfg.Provider.Qualities = make(map[string]string)
+			}
+			m.cfg.Provider.Qualities[m.cfg.Provider.Provider] = quality
+		}
 	case "sub_or_dub":
 		m.cfg.Playback.SubOrDub = fmt.Sprintf("%v", value)
 	case "subs_language":
 		m.cfg.Playback.SubsLanguage = fmt.Sprintf("%v", value)
+	case "completion_threshold", "next_episode_threshold":
+		// Not a number becomes 0, which validation rejects on save
+		percent, _ := strconv.Atoi(strings.TrimSpace(fmt.Sprintf("%v", value)))
+		if name == "completion_threshold" {
+			m.cfg.Playback.CompletionThreshold = percent
+		} else {
+			m.cfg.Playback.NextEpisodeThreshold = percent
+		}
+		utils.SetThresholds(m.cfg.Playback.CompletionThreshold, m.cfg.Playback.NextEpisodeThreshold)
 	case "persist_incognito_sessions":
 		if boolVal, ok := value.(bool); ok {
 			m.cfg.Playback.PersistIncognitoSessions = boolVal
 		} else if strVal, ok := value.(string); ok {
 			m.cfg.Playback.PersistIncognitoSessions = (strVal == "true")
 		}
+	case "resume_autoplay":
+		if boolVal, ok := value.(bool); ok {
+			m.cfg.Playback.ResumeAutoplay = boolVal
+		} else if strVal, ok := value.(string); ok {
+			m.cfg.Playback.ResumeAutoplay = (strVal == "true")
+		}
+	case "restore_session":
+		if boolVal, ok := value.(bool); ok {
+			m.cfg.UI.RestoreSession = boolVal
+		} else if strVal, ok := value.(string); ok {
+			m.cfg.UI.RestoreSession = (strVal == "true")
+		}
+	case "launch_action":
+		m.cfg.UI.LaunchAction = fmt.Sprintf("%v", value)
+	case "menu_items":
+		m.cfg.UI.MenuItems = fmt.Sprintf("%v", value)
 	case "discord_presence":
 		if boolVal, ok := value.(bool); ok {
 			m.cfg.Discord.DiscordPresence = boolVal
 		} else if strVal, ok := value.(string); ok {
 			m.cfg.Discord.DiscordPresence = (strVal == "true")
 		}
+	case "discord_app_id":
+		m.cfg.Discord.AppID = fmt.Sprintf("%v", value)
+	case "discord_details_template":
+		m.cfg.Discord.DetailsTemplate = fmt.Sprintf("%v", value)
+	case "discord_state_template":
+		m.cfg.Discord.StateTemplate = fmt.Sprintf("%v", value)
+	case "discord_small_image":
+		m.cfg.Discord.SmallImage = fmt.Sprintf("%v", value)
+	case "discord_small_text":
+		m.cfg.Discord.SmallText = fmt.Sprintf("%v", value)
+	case "private_incognito_updates":
+		if boolVal, ok := value.(bool); ok {
+			m.cfg.AniList.PrivateIncognitoUpdates = boolVal
+		} else if strVal, ok := value.(string); ok {
+			m.cfg.AniList.PrivateIncognitoUpdates = (strVal == "true")
+		}
+	case "auto_add_on_play":
+		if boolVal, ok := value.(bool); ok {
+			m.cfg.AniList.AutoAddOnPlay = boolVal
+		}
+	case "cache_refresh_minutes":
+		// Not a number becomes -1, which validation rejects on save
+		minutes, err := strconv.Atoi(strings.TrimSpace(fmt.Sprintf("%v", value)))
+		if err != nil {
+			minutes = -1
+		}
+		m.cfg.AniList.CacheRefreshMinutes = minutes
+	case "token_storage":
+		m.cfg.AniList.TokenStorage = fmt.Sprintf("%v", value)
+		anilist.SetKeyringEnabled(m.cfg.AniList.TokenStorage == "keyring")
 	case "show_adult_content":
 		if boolVal, ok := value.(bool); ok {
 			m.cfg.Advanced.ShowAdultContent = boolVal
 		} else if strVal, ok := value.(string); ok {
 			m.cfg.Advanced.ShowAdultContent = (strVal == "true")
 		}
+	case "log_level":
+		m.cfg.Advanced.LogLevel = fmt.Sprintf("%v", value)
+		if level, err := logger.ParseLevel(m.cfg.Advanced.LogLevel); err == nil {
+			logger.SetMinLevel(level)
+		}
+	case "data_dir":
+		// Files already open elsewhere would be split across two places, so this waits for the next launch
+		m.cfg.Advanced.DataDir = strings.TrimSpace(fmt.Sprintf("%v", value))
+	}
+}
+
+// providerQualityValue returns the quality override for the active provider, or "default" when unset
+func providerQualityValue(cfg *config.Config) string {
+	if quality, ok := cfg.Provider.Qualities[cfg.Provider.Provider]; ok && quality != "" {
+		return quality
 	}
+	return "default"
 }
 
 // View renders the config editor
@@ -402,6 +707,8 @@ func (m *ConfigEditor) View() string {
 				display = fmt.Sprintf("%s: [%s]", item.DisplayName, status)
 			case ConfigTypeSelect:
 				display = fmt.Sprintf("%s: %v", item.DisplayName, item.Value)
+			case ConfigTypeAction:
+				display = item.DisplayName
 			}
 
 			if m.cursor == i {
@@ -431,6 +738,10 @@ func (m *ConfigEditor) View() string {
 				key.WithKeys("s"),
 				key.WithHelp("s", "save"),
 			),
+			Reset: key.NewBinding(
+				key.WithKeys("R"),
+				key.WithHelp("R", "reset to defaults"),
+			),
 			Back: key.NewBinding(
 				key.WithKeys("esc"),
 				key.WithHelp("esc", "back"),
@@ -510,13 +821,118 @@ func (m *ConfigEditor) View() string {
 		s += m.help.View(extendedKeys)
 		return s
 
+	case ConfigLogView:
+		s := m.styles.Title.Render("Logs") + "\n\n"
+		logPath := logger.GetLogFilePath()
+		if logPath == "" {
+			logPath = "(logger not initialized)"
+		}
+		s += m.styles.Info.Render(fmt.Sprintf("Log file: %s (last %d lines)", logPath, logTailLines)) + "\n\n"
+		s += m.logViewport.View() + "\n\n"
+
+		helpKeys := configLogKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "scroll up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "scroll down"),
+			),
+			Reload: key.NewBinding(
+				key.WithKeys("r"),
+				key.WithHelp("r", "reload"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "back"),
+			),
+		}
+
+		extendedKeys := ExtendedKeyMap{
+			Universal: m.universalKeys,
+			ViewKeys:  helpKeys.ShortHelp(),
+			ViewFull:  helpKeys.FullHelp(),
+		}
+
+		s += m.help.View(extendedKeys)
+		return s
+
+	case ConfigClearHistoryConfirm:
+		s := m.styles.Title.Render("Clear Watch History") + "\n\n"
+		s += m.styles.Error.Render("This removes your local watch history and resume positions. AniList is not affected.") + "\n\n"
+
+		for i, option := range clearHistoryOptions {
+			if m.confirmCursor == i {
+				s += m.styles.SelectedItem.Render("> "+option) + "\n"
+			} else {
+				s += m.styles.MenuItem.Render("  "+option) + "\n"
+			}
+		}
+
+		helpKeys := configSelectKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "move up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "move down"),
+			),
+			Select: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "confirm"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "cancel"),
+			),
+		}
+
+		extendedKeys := ExtendedKeyMap{
+			Universal: m.universalKeys,
+			ViewKeys:  helpKeys.ShortHelp(),
+			ViewFull:  helpKeys.FullHelp(),
+		}
+
+		s += "\n" + m.help.View(extendedKeys)
+		return s
+
+	case ConfigResetConfirm:
+		s := m.styles.Title.Render("Reset to Defaults") + "\n\n"
+		s += m.styles.Error.Render("Restore every setting to its default? Your AniList login is kept.") + "\n"
+		s += m.styles.Info.Render("Nothing is written until you press s to save.") + "\n\n"
+
+		helpKeys := configEditKeyMap{
+			Enter: key.NewBinding(
+				key.WithKeys("y"),
+				key.WithHelp("y", "reset"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("n", "esc"),
+				key.WithHelp("n/esc", "cancel"),
+			),
+		}
+
+		extendedKeys := ExtendedKeyMap{
+			Universal: m.universalKeys,
+			ViewKeys:  helpKeys.ShortHelp(),
+			ViewFull:  helpKeys.FullHelp(),
+		}
+
+		s += m.help.View(extendedKeys)
+		return s
+
 	case ConfigSaving:
 		return m.styles.Info.Render("Saving settings...") + "\n"
 
 	case ConfigSaved:
 		if m.err != nil {
-			s := m.styles.Error.Render(fmt.Sprintf("Error saving settings: %v", m.err)) + "\n\n"
-			s += m.styles.Help.Render("press enter/esc to continue")
+			s := m.styles.Error.Render("Settings were not saved:") + "\n\n"
+			for _, line := range strings.Split(m.err.Error(), "\n") {
+				s += m.styles.Error.Render("  • "+line) + "\n"
+			}
+			s += "\n" + m.styles.Help.Render("press enter/esc to go back and fix them")
 			return s
 		}
 
@@ -534,6 +950,7 @@ type configMenuKeyMap struct {
 	Down   key.Binding
 	Select key.Binding
 	Save   key.Binding
+	Reset  key.Binding
 	Back   key.Binding
 }
 
@@ -544,7 +961,7 @@ func (k configMenuKeyMap) ShortHelp() []key.Binding {
 func (k configMenuKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Select, k.Save},
-		{k.Back},
+		{k.Reset, k.Back},
 	}
 }
 
@@ -580,3 +997,21 @@ func (k configSelectKeyMap) FullHelp() [][]key.Binding {
 		{k.Back},
 	}
 }
+
+type configLogKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Reload key.Binding
+	Back   key.Binding
+}
+
+func (k configLogKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Reload, k.Back}
+}
+
+func (k configLogKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Reload},
+		{k.Back},
+	}
+}
@@ -1,8 +1,12 @@
 package ui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -11,14 +15,27 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pranshuj73/oni/anilist"
 	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/player"
+	"github.com/pranshuj73/oni/providers"
+	"github.com/pranshuj73/oni/utils"
 )
 
+// availabilityTimeout bounds the background sub/dub availability lookup
+const availabilityTimeout = 15 * time.Second
+
+// episodeListHeight is how many episodes the list shows at once
+const episodeListHeight = 10
+
 // EpisodeSelectState represents the episode selection state
 type EpisodeSelectState int
 
 const (
 	EpisodeSubDubSelect EpisodeSelectState = iota
 	EpisodeNumberInput
+	EpisodeListSelect
+	EpisodeOffsetInput
+	EpisodeStartInput
 	EpisodeReady
 )
 
@@ -32,8 +49,21 @@ type EpisodeSelect struct {
 	episodesTotal   int
 	episodeInput    string
 	selectedEpisode int
+	selectedSpecial string
 	subOrDub        string
 	subDubCursor    int
+	offset          int
+	offsetInput     string
+	startAt         string // HH:MM:SS to start the episode at instead of its resume point
+	startInput      string
+	availability    *providers.Availability
+	episodes        []providers.Episode // Listed episodes in AniList numbering
+	listCursor      int
+	// Episodes as the provider lists them, kept so a new offset can map them again
+	providerEpisodes []providers.Episode
+	showPreviews    bool              // image_preview is on and chafa can draw
+	thumbnails      map[int]string    // Episode number to AniList thumbnail URL
+	previews        map[string]string // Image URL to drawn preview; "" while loading or failed
 	err             error
 	spinner         spinner.Model
 	help            help.Model
@@ -57,21 +87,57 @@ func (k episodeSelectKeyMap) FullHelp() [][]key.Binding {
 
 // episodeInputKeyMap defines the keybindings for episode input
 type episodeInputKeyMap struct {
-	Play key.Binding
-	Back key.Binding
+	Play   key.Binding
+	Offset key.Binding
+	Start  key.Binding
+	Back   key.Binding
 }
 
 func (k episodeInputKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Play, k.Back}
+	return []key.Binding{k.Play, k.Offset, k.Start, k.Back}
 }
 
 func (k episodeInputKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{{k.Play, k.Back}}
+	return [][]key.Binding{{k.Play, k.Offset, k.Start, k.Back}}
+}
+
+// episodeListKeyMap defines the keybindings for the episode list
+type episodeListKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Play   key.Binding
+	Number key.Binding
+	Offset key.Binding
+	Start  key.Binding
+	Back   key.Binding
+}
+
+func (k episodeListKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Play, k.Number, k.Offset, k.Start, k.Back}
+}
+
+func (k episodeListKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down, k.Play, k.Number, k.Offset, k.Start, k.Back}}
+}
+
+// episodeOffsetKeyMap defines the keybindings for episode offset input
+type episodeOffsetKeyMap struct {
+	Save key.Binding
+	Back key.Binding
+}
+
+func (k episodeOffsetKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Save, k.Back}
+}
+
+func (k episodeOffsetKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Save, k.Back}}
 }
 
 // NewEpisodeSelect creates a new episode selector
 func NewEpisodeSelect(cfg *config.Config, anime anilist.Anime, progress int) *EpisodeSelect {
-	episodesTotal := 9999
+	// 0 means AniList doesn't know the count yet, e.g. for an ongoing show
+	episodesTotal := 0
 	if anime.Episodes != nil {
 		episodesTotal = *anime.Episodes
 	}
@@ -92,6 +158,9 @@ func NewEpisodeSelect(cfg *config.Config, anime anilist.Anime, progress int) *Ep
 		episodesTotal: episodesTotal,
 		subOrDub:      cfg.Playback.SubOrDub,
 		subDubCursor:  0,
+		offset:        providers.LoadEpisodeOffset(cfg.Provider.Provider, anime.ID),
+		showPreviews:  previewsEnabled(cfg.UI.ImagePreview),
+		previews:      make(map[string]string),
 		spinner:       s,
 		help:          h,
 	}
@@ -111,13 +180,250 @@ func (m *EpisodeSelect) Init() tea.Cmd {
 		}
 	}
 	// Don't auto-play here - let user press Enter to play
-	return m.spinner.Tick
+	cmds := []tea.Cmd{m.spinner.Tick, m.fetchAvailability, m.fetchEpisodes}
+	if m.showPreviews && !m.cfg.AniList.NoAniList {
+		cmds = append(cmds, fetchEpisodeThumbnails(m.anime.ID))
+	}
+	return tea.Batch(cmds...)
+}
+
+// EpisodeListMsg carries the provider's episode list
+type EpisodeListMsg struct {
+	Episodes []providers.Episode
+}
+
+// fetchEpisodes asks the provider for episode titles, if it can list them
+func (m *EpisodeSelect) fetchEpisodes() tea.Msg {
+	provider, err := providers.GetProvider(m.cfg.Provider.Provider)
+	if err != nil {
+		return EpisodeListMsg{}
+	}
+	lister, ok := provider.(providers.EpisodeLister)
+	if !ok {
+		return EpisodeListMsg{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), availabilityTimeout)
+	defer cancel()
+
+	episodes, err := lister.ListEpisodes(ctx, m.anime.ID, m.anime.Title.Names())
+	if err != nil {
+		if !errors.Is(err, providers.ErrListingUnsupported) {
+			logger.Debug("Episode listing failed", map[string]interface{}{
+				"provider": provider.Name(),
+				"mediaID":  m.anime.ID,
+				"error":    err.Error(),
+			})
+		}
+		return EpisodeListMsg{}
+	}
+	return EpisodeListMsg{Episodes: episodes}
+}
+
+// applyEpisodeList keeps the episodes that map to AniList numbers and opens the list on the next one
+func (m *EpisodeSelect) applyEpisodeList(episodes []providers.Episode) {
+	m.episodes = nil
+	for _, ep := range episodes {
+		if n := ep.Number - m.offset; n >= 1 && (m.episodesTotal == 0 || n <= m.episodesTotal) {
+			m.episodes = append(m.episodes, providers.Episode{Number: n, Title: ep.Title})
+		}
+	}
+
+	next := m.progress + 1
+	if m.selectedEpisode > 0 {
+		next = m.selectedEpisode
+	}
+	m.listCursor = 0
+	for i, ep := range m.episodes {
+		if ep.Number <= next {
+			m.listCursor = i
+		}
+	}
+}
+
+// inputState is where episode entry happens: the list when one is loaded, else the number prompt
+func (m *EpisodeSelect) inputState() EpisodeSelectState {
+	if len(m.episodes) > 0 {
+		return EpisodeListSelect
+	}
+	return EpisodeNumberInput
+}
+
+// EpisodeAvailabilityMsg carries the provider's sub/dub episode counts
+type EpisodeAvailabilityMsg struct {
+	Availability *providers.Availability
+}
+
+// fetchAvailability asks the provider which audio types it has for this anime
+func (m *EpisodeSelect) fetchAvailability() tea.Msg {
+	provider, err := providers.GetProvider(m.cfg.Provider.Provider)
+	if err != nil {
+		return EpisodeAvailabilityMsg{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), availabilityTimeout)
+	defer cancel()
+
+	info, err := provider.GetEpisodeInfo(ctx, m.anime.ID, strconv.Itoa(max(1, m.progress+1+m.offset)), m.anime.Title.Names())
+	if err != nil {
+		logger.Debug("Sub/dub availability lookup failed", map[string]interface{}{
+			"provider": provider.Name(),
+			"mediaID":  m.anime.ID,
+			"error":    err.Error(),
+		})
+		return EpisodeAvailabilityMsg{}
+	}
+	return EpisodeAvailabilityMsg{Availability: info.Availability}
+}
+
+// dubUnavailable reports whether the provider is known to have no dub
+func (m *EpisodeSelect) dubUnavailable() bool {
+	return m.availability != nil && !m.availability.HasDub()
+}
+
+// previewURL returns the image for the highlighted episode: its thumbnail, or the cover when there is none
+func (m *EpisodeSelect) previewURL() string {
+	if !m.showPreviews || len(m.episodes) == 0 {
+		return ""
+	}
+	if url, ok := m.thumbnails[m.episodes[m.listCursor].Number]; ok {
+		return url
+	}
+	return m.anime.CoverImage.Large
+}
+
+// previewCmd starts drawing the highlighted episode's preview unless it is already drawn or loading
+func (m *EpisodeSelect) previewCmd() tea.Cmd {
+	url := m.previewURL()
+	if url == "" {
+		return nil
+	}
+	if _, seen := m.previews[url]; seen {
+		return nil
+	}
+	m.previews[url] = ""
+	return loadPreview(url)
+}
+
+// totalLabel returns the episode count for display, or "?" when it isn't known
+func (m *EpisodeSelect) totalLabel() string {
+	if m.episodesTotal == 0 {
+		return "?"
+	}
+	return strconv.Itoa(m.episodesTotal)
+}
+
+// providerEpisodeCount returns how many episodes the provider has, in AniList numbering, and whether it's known
+// It takes the lower of the provider's count for the chosen audio type and the last listed episode
+func (m *EpisodeSelect) providerEpisodeCount() (int, bool) {
+	available, known := 0, false
+	if m.availability != nil {
+		count := m.availability.Sub
+		if m.subOrDub == "dub" {
+			count = m.availability.Dub
+		}
+		// Counts are in provider numbering, so take the offset off to compare with AniList's
+		if count > 0 {
+			available, known = max(0, count-m.offset), true
+		}
+	}
+	if len(m.episodes) > 0 {
+		last := 0
+		for _, listed := range m.episodes {
+			last = max(last, listed.Number)
+		}
+		if !known || last < available {
+			available, known = last, true
+		}
+	}
+	return available, known
+}
+
+// episodeCountWarning says when AniList lists more episodes than the provider has, e.g. for a show still airing
+func (m *EpisodeSelect) episodeCountWarning() string {
+	available, ok := m.providerEpisodeCount()
+	if !ok || m.episodesTotal == 0 || available >= m.episodesTotal {
+		return ""
+	}
+	audio := ""
+	if m.availability != nil {
+		audio = " in " + m.subOrDub
+	}
+	return fmt.Sprintf("AniList lists %d episodes but %s only has %d%s", m.episodesTotal, m.cfg.Provider.Provider, available, audio)
+}
+
+// unavailableError explains why the provider can't play episode ep, or returns nil when it might
+func (m *EpisodeSelect) unavailableError(ep int) error {
+	available, ok := m.providerEpisodeCount()
+	if !ok || ep <= available {
+		return nil
+	}
+	if warning := m.episodeCountWarning(); warning != "" {
+		return errors.New(warning)
+	}
+	if m.availability != nil {
+		return fmt.Errorf("only %d episodes available in %s on %s", available, m.subOrDub, m.cfg.Provider.Provider)
+	}
+	return fmt.Errorf("only %d episodes available on %s", available, m.cfg.Provider.Provider)
+}
+
+// parseEpisodeInput splits input like "6.5" into the regular episode before it and the special's label
+func parseEpisodeInput(input string) (int, string, error) {
+	if !strings.Contains(input, ".") {
+		ep, err := strconv.Atoi(input)
+		return ep, "", err
+	}
+	num, err := strconv.ParseFloat(input, 64)
+	if err != nil || num <= 0 {
+		return 0, "", fmt.Errorf("invalid episode number %q", input)
+	}
+	if num == float64(int(num)) {
+		// "6." or "6.0" is just episode 6
+		return int(num), "", nil
+	}
+	return int(num), strconv.FormatFloat(num, 'f', -1, 64), nil
+}
+
+// parseStartTime reads a start time like "1:30" or "00:01:30" and returns it in seconds
+func parseStartTime(input string) (int, bool) {
+	parts := strings.Split(input, ":")
+	seconds := 0
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, false
+		}
+		// Only the leading field may go past 59, so "90:00" works but "1:75" doesn't
+		if i > 0 && n > 59 {
+			return 0, false
+		}
+		seconds = seconds*60 + n
+	}
+	return seconds, true
+}
+
+// startTimeError reports a start time past the end of episode ep, when its length is known from history
+func (m *EpisodeSelect) startTimeError(ep int) error {
+	if m.startAt == "" || m.selectedSpecial != "" {
+		return nil
+	}
+	entry, err := player.GetHistoryEntry(m.anime.ID, ep)
+	if err != nil || entry == nil {
+		return nil
+	}
+	start, _ := utils.ParseTimestamp(m.startAt)
+	if duration, ok := utils.ParseTimestamp(entry.Duration); ok && duration > 0 && start >= duration {
+		return fmt.Errorf("episode %d is only %s long", ep, entry.Duration)
+	}
+	return nil
 }
 
 // EpisodeReadyMsg is sent when episode selection is complete
 type EpisodeReadyMsg struct {
 	Episode  int
+	Special  string // Decimal episode like "6.5", with Episode holding the one before it
 	SubOrDub string
+	StartAt  string // HH:MM:SS overriding the resume point, if set
 }
 
 // Update handles messages
@@ -128,6 +434,36 @@ func (m *EpisodeSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
 
+	case EpisodeAvailabilityMsg:
+		m.availability = msg.Availability
+		if m.dubUnavailable() {
+			m.subDubCursor = 0
+		}
+		return m, nil
+
+	case EpisodeListMsg:
+		m.providerEpisodes = msg.Episodes
+		m.applyEpisodeList(msg.Episodes)
+		// Only switch over if the user hasn't started typing a number
+		if m.state == EpisodeNumberInput && m.episodeInput == "" {
+			m.state = m.inputState()
+		}
+		return m, m.previewCmd()
+
+	case EpisodeThumbnailsMsg:
+		m.thumbnails = msg.Thumbnails
+		return m, m.previewCmd()
+
+	case PreviewMsg:
+		if msg.Err != nil {
+			logger.Debug("Failed to load preview", map[string]interface{}{
+				"url":   msg.URL,
+				"error": msg.Err.Error(),
+			})
+		}
+		m.previews[msg.URL] = msg.Art
+		return m, nil
+
 	case tea.KeyMsg:
 		switch m.state {
 		case EpisodeSubDubSelect:
@@ -139,25 +475,32 @@ func (m *EpisodeSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.subDubCursor = 0
 
 			case "down", "j":
-				m.subDubCursor = 1
+				if !m.dubUnavailable() {
+					m.subDubCursor = 1
+				}
 
 			case "enter":
-				if m.subDubCursor == 0 {
+				if m.subDubCursor == 0 || m.dubUnavailable() {
 					m.subOrDub = "sub"
 				} else {
 					m.subOrDub = "dub"
 				}
-				m.state = EpisodeNumberInput
+				m.state = m.inputState()
 			}
 
 		case EpisodeNumberInput:
 			switch msg.String() {
-			case "ctrl+c", "esc", "q":
+			case "esc":
 				return m, func() tea.Msg { return BackMsg{} }
 
 			case "backspace":
 				// Check if we should go back or delete character
 				if len(m.episodeInput) == 0 {
+					if len(m.episodes) > 0 {
+						m.state = EpisodeListSelect
+						m.err = nil
+						return m, nil
+					}
 					return m, func() tea.Msg { return BackMsg{} }
 				}
 				if len(m.episodeInput) > 0 {
@@ -172,27 +515,188 @@ func (m *EpisodeSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.selectedEpisode = m.progress + 1
 					}
 				} else {
-					ep, err := strconv.Atoi(m.episodeInput)
-					if err != nil || ep < 1 || ep > m.episodesTotal {
+					ep, special, err := parseEpisodeInput(m.episodeInput)
+					if err != nil || (ep < 1 && special == "") || (m.episodesTotal > 0 && ep > m.episodesTotal) {
 						m.err = fmt.Errorf("invalid episode number")
 						return m, nil
 					}
 					m.selectedEpisode = ep
+					m.selectedSpecial = special
+				}
+				if m.selectedSpecial == "" {
+					if err := m.unavailableError(m.selectedEpisode); err != nil {
+						m.err = err
+						return m, nil
+					}
+				}
+				if err := m.startTimeError(m.selectedEpisode); err != nil {
+					m.err = err
+					return m, nil
+				}
+
+				m.state = EpisodeReady
+				return m, func() tea.Msg {
+					return EpisodeReadyMsg{
+						Episode:  m.selectedEpisode,
+						Special:  m.selectedSpecial,
+						SubOrDub: m.subOrDub,
+						StartAt:  m.startAt,
+					}
+				}
+
+			case "o":
+				// Only before typing starts, so the shortcut can't interrupt a number
+				if m.episodeInput != "" {
+					return m, nil
+				}
+				m.state = EpisodeOffsetInput
+				m.offsetInput = ""
+				if m.offset != 0 {
+					m.offsetInput = strconv.Itoa(m.offset)
+				}
+				m.err = nil
+
+			case "t":
+				// Like o, only before typing starts
+				if m.episodeInput != "" {
+					return m, nil
+				}
+				m.openStartInput()
+
+			default:
+				// Accept digits and a single decimal point for specials like 6.5
+				m.episodeInput = appendDigits(m.episodeInput, msg, ".")
+			}
+
+		case EpisodeListSelect:
+			switch msg.String() {
+			case "ctrl+c", "esc", "q", "backspace":
+				return m, func() tea.Msg { return BackMsg{} }
+
+			case "up", "k":
+				if m.listCursor > 0 {
+					m.listCursor--
+				}
+				m.err = nil
+				return m, m.previewCmd()
+
+			case "down", "j":
+				if m.listCursor < len(m.episodes)-1 {
+					m.listCursor++
 				}
+				m.err = nil
+				return m, m.previewCmd()
 
+			case "enter":
+				// The list may hold subbed episodes that aren't dubbed yet
+				if err := m.unavailableError(m.episodes[m.listCursor].Number); err != nil {
+					m.err = err
+					return m, nil
+				}
+				m.selectedSpecial = ""
+				if err := m.startTimeError(m.episodes[m.listCursor].Number); err != nil {
+					m.err = err
+					return m, nil
+				}
+				m.selectedEpisode = m.episodes[m.listCursor].Number
 				m.state = EpisodeReady
 				return m, func() tea.Msg {
 					return EpisodeReadyMsg{
 						Episode:  m.selectedEpisode,
 						SubOrDub: m.subOrDub,
+						StartAt:  m.startAt,
 					}
 				}
 
+			case "o":
+				m.state = EpisodeOffsetInput
+				m.offsetInput = ""
+				if m.offset != 0 {
+					m.offsetInput = strconv.Itoa(m.offset)
+				}
+				m.err = nil
+
+			case "t":
+				m.openStartInput()
+
 			default:
-				// Only accept numeric input
-				if msg.String() >= "0" && msg.String() <= "9" {
-					m.episodeInput += msg.String()
+				// Typing a digit switches to the number prompt
+				if input := appendDigits("", msg, ""); input != "" {
+					m.state = EpisodeNumberInput
+					m.episodeInput = input
+				}
+			}
+
+		case EpisodeOffsetInput:
+			switch msg.String() {
+			case "ctrl+c", "esc":
+				m.state = m.inputState()
+				m.err = nil
+
+			case "backspace":
+				if len(m.offsetInput) == 0 {
+					m.state = m.inputState()
+					return m, nil
+				}
+				m.offsetInput = m.offsetInput[:len(m.offsetInput)-1]
+
+			case "enter":
+				offset := 0
+				if m.offsetInput != "" && m.offsetInput != "-" {
+					var err error
+					offset, err = strconv.Atoi(m.offsetInput)
+					if err != nil {
+						m.err = fmt.Errorf("invalid offset")
+						return m, nil
+					}
+				}
+				if err := providers.SaveEpisodeOffset(m.cfg.Provider.Provider, m.anime.ID, offset); err != nil {
+					m.err = fmt.Errorf("failed to save offset: %w", err)
+					return m, nil
+				}
+				// Map the provider's episodes again; ones the old offset left out may fit now
+				m.offset = offset
+				if len(m.providerEpisodes) > 0 {
+					m.applyEpisodeList(m.providerEpisodes)
+				}
+				m.err = nil
+				m.state = m.inputState()
+
+			default:
+				// Accept numeric input, with a leading minus sign for negative offsets
+				m.offsetInput = appendDigits(m.offsetInput, msg, "-")
+			}
+
+		case EpisodeStartInput:
+			switch msg.String() {
+			case "esc":
+				m.state = m.inputState()
+				m.err = nil
+
+			case "backspace":
+				if len(m.startInput) == 0 {
+					m.state = m.inputState()
+					return m, nil
+				}
+				m.startInput = m.startInput[:len(m.startInput)-1]
+
+			case "enter":
+				// An empty time goes back to resuming where the episode was left
+				if m.startInput == "" {
+					m.startAt = ""
+				} else {
+					seconds, ok := parseStartTime(m.startInput)
+					if !ok {
+						m.err = fmt.Errorf("invalid time %q, use HH:MM:SS or MM:SS", m.startInput)
+						return m, nil
+					}
+					m.startAt = utils.FormatTimestamp(seconds)
 				}
+				m.err = nil
+				m.state = m.inputState()
+
+			default:
+				m.startInput = appendTimestamp(m.startInput, msg)
 			}
 		}
 	}
@@ -200,6 +704,21 @@ func (m *EpisodeSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// openStartInput switches to the start time prompt, filled with the current start time
+func (m *EpisodeSelect) openStartInput() {
+	m.state = EpisodeStartInput
+	m.startInput = m.startAt
+	m.err = nil
+}
+
+// startAtInfo returns the line noting a start time override, or "" when there is none
+func (m *EpisodeSelect) startAtInfo() string {
+	if m.startAt == "" {
+		return ""
+	}
+	return m.styles.Info.Render(fmt.Sprintf("Starting at %s instead of the resume point", m.startAt)) + "\n"
+}
+
 // View renders the episode selector
 func (m *EpisodeSelect) View() string {
 	switch m.state {
@@ -207,8 +726,16 @@ func (m *EpisodeSelect) View() string {
 		s := m.styles.Title.Render("Select Audio Type") + "\n\n"
 
 		options := []string{"Sub", "Dub"}
+		if m.availability != nil {
+			options[0] = fmt.Sprintf("Sub (%d episodes)", m.availability.Sub)
+			options[1] = fmt.Sprintf("Dub (%d episodes)", m.availability.Dub)
+		}
 		for i, opt := range options {
 			cursor := " "
+			if i == 1 && m.dubUnavailable() {
+				s += m.styles.Info.Render(cursor+" Dub (not available)") + "\n"
+				continue
+			}
 			if m.subDubCursor == i {
 				cursor = ">"
 				s += m.styles.SelectedItem.Render(cursor + " " + opt) + "\n"
@@ -228,7 +755,21 @@ func (m *EpisodeSelect) View() string {
 
 	case EpisodeNumberInput:
 		s := m.styles.Title.Render(m.anime.Title.UserPreferred) + "\n\n"
-		s += m.styles.Info.Render(fmt.Sprintf("Current progress: %d/%d episodes", m.progress, m.episodesTotal)) + "\n\n"
+		s += m.styles.Info.Render(fmt.Sprintf("Current progress: %d/%s episodes", m.progress, m.totalLabel())) + "\n"
+		if m.offset != 0 {
+			s += m.styles.Info.Render(fmt.Sprintf("Episode offset on %s: %+d", m.cfg.Provider.Provider, m.offset)) + "\n"
+		}
+		s += m.startAtInfo()
+		if m.availability != nil {
+			s += m.styles.Info.Render(fmt.Sprintf("Available on %s: %d sub, %d dub", m.cfg.Provider.Provider, m.availability.Sub, m.availability.Dub)) + "\n"
+			if m.subOrDub == "dub" && !m.availability.HasDub() {
+				s += m.styles.Error.Render("No dub available on this provider - playback will likely fail") + "\n"
+			}
+		}
+		if warning := m.episodeCountWarning(); warning != "" {
+			s += m.styles.Error.Render(warning) + "\n"
+		}
+		s += "\n"
 		nextEp := m.progress + 1
 		if m.selectedEpisode > 0 {
 			nextEp = m.selectedEpisode
@@ -245,8 +786,96 @@ func (m *EpisodeSelect) View() string {
 		}
 
 		keys := episodeInputKeyMap{
-			Play: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "play")),
-			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+			Play:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "play")),
+			Offset: key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "episode offset")),
+			Start:  key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "start at")),
+			Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		}
+		s += m.help.View(keys)
+		return s
+
+	case EpisodeListSelect:
+		s := m.styles.Title.Render(m.anime.Title.UserPreferred) + "\n\n"
+		s += m.styles.Info.Render(fmt.Sprintf("Current progress: %d/%s episodes", m.progress, m.totalLabel())) + "\n"
+		if m.offset != 0 {
+			s += m.styles.Info.Render(fmt.Sprintf("Episode offset on %s: %+d", m.cfg.Provider.Provider, m.offset)) + "\n"
+		}
+		s += m.startAtInfo()
+		if warning := m.episodeCountWarning(); warning != "" {
+			s += m.styles.Error.Render(warning) + "\n"
+		}
+		s += "\n"
+
+		// Window the list around the cursor
+		start := max(0, min(m.listCursor-episodeListHeight/2, len(m.episodes)-episodeListHeight))
+		end := min(len(m.episodes), start+episodeListHeight)
+		var rows []string
+		for i := start; i < end; i++ {
+			ep := m.episodes[i]
+			line := fmt.Sprintf("%3d. %s", ep.Number, ep.Title)
+			if ep.Number <= m.progress {
+				line += " ✓"
+			}
+			if i == m.listCursor {
+				rows = append(rows, m.styles.SelectedItem.Render("> "+line))
+			} else {
+				rows = append(rows, m.styles.MenuItem.Render("  "+line))
+			}
+		}
+		episodeList := strings.Join(rows, "\n")
+		if art := m.previews[m.previewURL()]; art != "" {
+			episodeList = lipgloss.JoinHorizontal(lipgloss.Top, episodeList, "  ", art)
+		}
+		s += episodeList + "\n\n"
+
+		if m.err != nil {
+			s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+		}
+
+		keys := episodeListKeyMap{
+			Up:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+			Down:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+			Play:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "play")),
+			Number: key.NewBinding(key.WithKeys("0", "1", "2", "3", "4", "5", "6", "7", "8", "9"), key.WithHelp("0-9", "type number")),
+			Offset: key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "episode offset")),
+			Start:  key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "start at")),
+			Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		}
+		s += m.help.View(keys)
+		return s
+
+	case EpisodeOffsetInput:
+		s := m.styles.Title.Render(m.anime.Title.UserPreferred) + "\n\n"
+		s += m.styles.Info.Render(fmt.Sprintf("Use this when %s numbers episodes differently from AniList.", m.cfg.Provider.Provider)) + "\n"
+		s += m.styles.Info.Render("e.g. enter 12 if episode 1 here is episode 13 on the provider, or 0 to clear.") + "\n\n"
+		s += m.styles.Prompt.Render("Enter episode offset:") + "\n"
+		s += m.styles.MenuItem.Render(m.offsetInput + "█") + "\n\n"
+
+		if m.err != nil {
+			s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+		}
+
+		keys := episodeOffsetKeyMap{
+			Save: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		}
+		s += m.help.View(keys)
+		return s
+
+	case EpisodeStartInput:
+		s := m.styles.Title.Render(m.anime.Title.UserPreferred) + "\n\n"
+		s += m.styles.Info.Render("Start the episode here instead of where you left off, e.g. 1:30 to skip a recap.") + "\n"
+		s += m.styles.Info.Render("Leave it empty to resume as usual.") + "\n\n"
+		s += m.styles.Prompt.Render("Start at (HH:MM:SS):") + "\n"
+		s += m.styles.MenuItem.Render(m.startInput + "█") + "\n\n"
+
+		if m.err != nil {
+			s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+		}
+
+		keys := episodeOffsetKeyMap{
+			Save: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save")),
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
 		}
 		s += m.help.View(keys)
 		return s
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/providers"
+)
+
+// describeError turns a failed search or list fetch into a message and a hint on what to do about it,
+// so a service that can't be reached doesn't read like a search with no matches
+func describeError(err error) (string, string) {
+	var rateLimit *anilist.RateLimitError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &rateLimit):
+		return "AniList is rate limiting requests", fmt.Sprintf("Wait %s and try again.", rateLimit.RetryAfter)
+	case errors.Is(err, anilist.ErrRateLimited):
+		return "AniList is rate limiting requests", "Wait a minute and try again."
+	case errors.Is(err, anilist.ErrInvalidToken):
+		return "AniList didn't accept your login", "Your token may have expired. Re-authenticate from Settings."
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "The request timed out", "The service may be slow or down. Check your connection and try again."
+	case errors.Is(err, anilist.ErrOffline):
+		return "Can't reach AniList", "You may be offline, or AniList may be down. Check your connection and try again."
+	case errors.Is(err, providers.ErrSearchUnsupported):
+		return "This provider can't search", "Pick another provider in Settings."
+	}
+	return fmt.Sprintf("Error: %v", err), ""
+}
+
+// renderError renders an error with the hint from describeError on the line below
+func renderError(styles Styles, err error) string {
+	message, hint := describeError(err)
+	s := styles.Error.Render(message) + "\n"
+	if hint != "" {
+		s += styles.Info.Render(hint) + "\n"
+	}
+	return s
+}
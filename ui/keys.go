@@ -1,7 +1,11 @@
 package ui
 
 import (
+	"strings"
+	"unicode"
+
 	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // UniversalKeys defines keybindings available in all views
@@ -59,3 +63,42 @@ func (k ExtendedKeyMap) FullHelp() [][]key.Binding {
 	return full
 }
 
+
+// typedText returns the printable text of a typed or pasted key, or "" for special keys
+// Letters reach text inputs this way instead of matching shortcuts like q or s
+func typedText(msg tea.KeyMsg) string {
+	if msg.Type != tea.KeyRunes && msg.Type != tea.KeySpace {
+		return ""
+	}
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) {
+			return -1
+		}
+		return r
+	}, string(msg.Runes))
+}
+
+// appendDigits adds the digits of a typed or pasted key to a numeric input
+// extra lists other characters the input accepts, each at most once; "-" is only taken first
+func appendDigits(input string, msg tea.KeyMsg, extra string) string {
+	for _, r := range typedText(msg) {
+		switch {
+		case r >= '0' && r <= '9':
+			input += string(r)
+		case r == '-' && input != "":
+		case strings.ContainsRune(extra, r) && !strings.ContainsRune(input, r):
+			input += string(r)
+		}
+	}
+	return input
+}
+
+// appendTimestamp adds the digits and colons of a typed or pasted key to an HH:MM:SS input
+func appendTimestamp(input string, msg tea.KeyMsg) string {
+	for _, r := range typedText(msg) {
+		if (r >= '0' && r <= '9') || (r == ':' && strings.Count(input, ":") < 2) {
+			input += string(r)
+		}
+	}
+	return input
+}
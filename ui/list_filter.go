@@ -0,0 +1,40 @@
+package ui
+
+import "github.com/charmbracelet/bubbles/list"
+
+// filterKeyOutcome is what a key did to a list's filter
+type filterKeyOutcome int
+
+const (
+	filterKeyPassThrough filterKeyOutcome = iota // Not a filter key; the screen handles it (enter selects)
+	filterKeyEdit                                // Opened the filter or typed into it
+	filterKeyConfirm                             // Enter accepted the filter; an empty one just closes it
+	filterKeyClear                               // Esc dropped the filter
+)
+
+// classifyFilterKey decides what a key did to the filter from the list's state before and after
+// the list handled it. Anything but filterKeyPassThrough belongs to the filter, so the screen
+// must not also select, go back or switch tabs on it
+func classifyFilterKey(before, after list.FilterState, key string) filterKeyOutcome {
+	switch before {
+	case list.Filtering:
+		// While typing, every key goes to the filter input
+		switch key {
+		case "enter":
+			return filterKeyConfirm
+		case "esc":
+			return filterKeyClear
+		}
+		return filterKeyEdit
+	case list.FilterApplied:
+		// Esc first clears an applied filter, only the next one leaves the screen
+		if key == "esc" {
+			return filterKeyClear
+		}
+	}
+	if after == list.Filtering {
+		// "/" opened the filter
+		return filterKeyEdit
+	}
+	return filterKeyPassThrough
+}
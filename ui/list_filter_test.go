@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+func TestClassifyFilterKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		before list.FilterState
+		after  list.FilterState
+		key    string
+		want   filterKeyOutcome
+	}{
+		{"enter selects with no filter", list.Unfiltered, list.Unfiltered, "enter", filterKeyPassThrough},
+		{"esc leaves with no filter", list.Unfiltered, list.Unfiltered, "esc", filterKeyPassThrough},
+		{"slash opens the filter", list.Unfiltered, list.Filtering, "/", filterKeyEdit},
+		{"typing edits the filter", list.Filtering, list.Filtering, "a", filterKeyEdit},
+		{"keys that select elsewhere edit while typing", list.Filtering, list.Filtering, "l", filterKeyEdit},
+		{"enter confirms the filter", list.Filtering, list.FilterApplied, "enter", filterKeyConfirm},
+		{"enter on an empty filter closes it", list.Filtering, list.Unfiltered, "enter", filterKeyConfirm},
+		{"esc while typing clears", list.Filtering, list.Unfiltered, "esc", filterKeyClear},
+		{"esc clears an applied filter", list.FilterApplied, list.Unfiltered, "esc", filterKeyClear},
+		{"enter selects from an applied filter", list.FilterApplied, list.FilterApplied, "enter", filterKeyPassThrough},
+		{"slash reopens an applied filter", list.FilterApplied, list.Filtering, "/", filterKeyEdit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyFilterKey(tt.before, tt.after, tt.key); got != tt.want {
+				t.Errorf("classifyFilterKey(%v, %v, %q) = %v, want %v", tt.before, tt.after, tt.key, got, tt.want)
+			}
+		})
+	}
+}
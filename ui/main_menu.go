@@ -2,9 +2,8 @@ package ui
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
-	"time"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -13,6 +12,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pranshuj73/oni/anilist"
 	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
 	"github.com/pranshuj73/oni/player"
 	"github.com/pranshuj73/oni/utils"
 )
@@ -33,6 +33,10 @@ type MainMenu struct {
 	spinner       spinner.Model
 	fetchingAnime bool
 	incognitoMode bool // Runtime incognito mode (not persisted)
+	// Selections behind options, which may carry extra text such as the show to continue
+	items []string
+	// AniList couldn't be reached at startup, so only local history is available
+	offline bool
 }
 
 // mainMenuKeyMap defines the keybindings for the main menu
@@ -98,16 +102,19 @@ func NewMainMenu(cfg *config.Config) *MainMenu {
 	return NewMainMenuWithClient(cfg, nil)
 }
 
+// menuSelections maps the menu_items names to the menu entries they show
+var menuSelections = map[string]string{
+	"continue": "Continue Watching",
+	"recent":   "Recent",
+	"watch":    "Watch Anime",
+	"season":   "Browse Season",
+	"update":   "Update Progress/Status/Score",
+	"settings": "Settings",
+	"quit":     "Quit",
+}
+
 // NewMainMenuWithClient creates a new main menu with an AniList client
 func NewMainMenuWithClient(cfg *config.Config, client *anilist.Client) *MainMenu {
-	options := []string{
-		"Continue Watching",
-		"Watch Anime",
-		"Update Progress/Status/Score",
-		"Settings",
-		"Quit",
-	}
-
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("5"))
@@ -117,7 +124,6 @@ func NewMainMenuWithClient(cfg *config.Config, client *anilist.Client) *MainMenu
 		client:        client,
 		styles:        DefaultStyles(),
 		cursor:        0,
-		options:       options,
 		help:          help.New(),
 		keys:          DefaultMainMenuKeyMap(),
 		universalKeys: DefaultUniversalKeys(),
@@ -125,9 +131,45 @@ func NewMainMenuWithClient(cfg *config.Config, client *anilist.Client) *MainMenu
 	}
 	// Start with short help by default
 	mm.help.ShowAll = false
+	mm.buildOptions()
 	return mm
 }
 
+// buildOptions lays the menu out from menu_items, so changes made in Settings show on return
+func (m *MainMenu) buildOptions() {
+	m.items = nil
+	for _, name := range m.cfg.MenuItems() {
+		if selection, ok := menuSelections[name]; ok {
+			m.items = append(m.items, selection)
+		}
+	}
+	// Settings stays on e and quitting on q, but a menu with nothing in it would be a dead end
+	if len(m.items) == 0 {
+		for _, name := range config.MenuItemNames {
+			m.items = append(m.items, menuSelections[name])
+		}
+	}
+	m.options = append([]string(nil), m.items...)
+	if m.cursor >= len(m.options) {
+		m.cursor = 0
+	}
+}
+
+// continueIndex returns the position of Continue Watching in the menu, or -1 when it is hidden
+func (m *MainMenu) continueIndex() int {
+	for i, item := range m.items {
+		if item == "Continue Watching" {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetOffline marks the menu as running without AniList because it couldn't be reached
+func (m *MainMenu) SetOffline(offline bool) {
+	m.offline = offline
+}
+
 // SetClient sets the AniList client and fetches continue watching anime
 func (m *MainMenu) SetClient(client *anilist.Client) {
 	m.client = client
@@ -137,27 +179,72 @@ func (m *MainMenu) SetClient(client *anilist.Client) {
 type ContinueWatchingAnimeMsg struct {
 	AnimeName string
 	Episode   int
+	Label     string // Episode label including how far into it the user got
 }
 
 // Init initializes the main menu
 func (m *MainMenu) Init() tea.Cmd {
+	m.buildOptions()
 	cmds := []tea.Cmd{m.spinner.Tick}
 	m.fetchingAnime = true
 	cmds = append(cmds, m.fetchContinueWatchingAnime())
 	return tea.Batch(cmds...)
 }
 
-// shortenTitle shortens an anime title by:
-// 1. Using the part before ":" if it exists
-// 2. Otherwise using the original title
+// minShortTitleLength is the shortest part before a subtitle that still names the show on its own
+const minShortTitleLength = 4
+
+// sequelWords mark a subtitle that tells a season or part apart, so dropping it would name the wrong entry
+var sequelWords = []string{"part", "season", "cour", "final", "arc"}
+
+// shortenTitle drops a subtitle from an anime title, e.g. "Frieren: Beyond Journey's End" becomes "Frieren"
+// Only a ": " separates a subtitle, so titles like "Re:Zero kara Hajimeru" are kept whole, and so is any
+// title whose part before it is too short to go by, like "Re: Zero", or whose subtitle names the season
+// or part, like "JoJo: Part 3"
 func shortenTitle(title string) string {
-	if idx := strings.Index(title, ":"); idx > 0 {
-		short := strings.TrimSpace(title[:idx])
-		if len(short) > 0 {
-			return short
+	idx := strings.Index(title, ": ")
+	if idx <= 0 {
+		return title
+	}
+	short := strings.TrimSpace(title[:idx])
+	if utf8.RuneCountInString(short) < minShortTitleLength || isSequelSubtitle(title[idx+2:]) {
+		return title
+	}
+	return short
+}
+
+// isSequelSubtitle reports whether a subtitle numbers or names a season or part, e.g. "Part 3" or "The Final Season"
+func isSequelSubtitle(subtitle string) bool {
+	if strings.ContainsAny(subtitle, "0123456789") {
+		return true
+	}
+	for _, word := range strings.Fields(strings.ToLower(subtitle)) {
+		for _, sequel := range sequelWords {
+			if word == sequel {
+				return true
+			}
 		}
 	}
-	return title
+	return false
+}
+
+// resumeLabel describes the episode an entry resumes at, with how far into it the user got
+func resumeLabel(entry player.HistoryEntry) string {
+	episode := entry.NextEpisode()
+	label := fmt.Sprintf("Episode %d", episode)
+
+	// Only show a percentage when resuming partway through an episode
+	if percentage, ok := entry.WatchedPercentage(); ok && episode == entry.Progress && !utils.IsEpisodeComplete(percentage) && percentage >= 1 {
+		label += fmt.Sprintf(" • %d%%", int(percentage))
+	}
+	// Resuming reuses the voiceover and provider the episode was played with, so say which
+	if entry.SubOrDub != "" {
+		label += " • " + entry.SubOrDub
+	}
+	if entry.Provider != "" {
+		label += " • " + entry.Provider
+	}
+	return label
 }
 
 // fetchContinueWatchingAnime fetches the anime name for continue watching from local history
@@ -165,76 +252,14 @@ func (m *MainMenu) fetchContinueWatchingAnime() tea.Cmd {
 	return func() tea.Msg {
 		// Use incognito or normal history based on current mode
 		history, err := player.LoadHistoryWithIncognito(m.incognitoMode)
-		if err == nil && len(history) > 0 {
-			// Find the entry with the most recent LastWatched timestamp
-			var lastEntry *player.HistoryEntry
-			var latestTime time.Time
-			
-			for i := range history {
-				entry := &history[i]
-				if entry.Title == "" {
-					continue
-				}
-				
-				// Parse LastWatched timestamp (RFC3339 format)
-				watchedTime, err := time.Parse(time.RFC3339, entry.LastWatched)
-				if err != nil {
-					// If LastWatched is missing or invalid (old format), skip this entry
-					// We can't determine when it was last watched without a proper timestamp
-					continue
-				}
-				
-				// Check if this is the most recent
-				if lastEntry == nil || watchedTime.After(latestTime) {
-					lastEntry = entry
-					latestTime = watchedTime
-				}
-			}
-			
-			if lastEntry != nil {
-				// Just shorten the stored title by splitting on colon
-				shortTitle := shortenTitle(lastEntry.Title)
-				
-				// Calculate completion percentage from Timestamp and Duration
-				// Only show next episode if previous episode was at least 95% complete
-				var episodeToShow int
-				var isComplete bool
-				
-				if lastEntry.Duration != "" && lastEntry.Timestamp != "" && lastEntry.Timestamp != "00:00:00" {
-					// Parse timestamp (current position)
-					timestampParts := strings.Split(lastEntry.Timestamp, ":")
-					if len(timestampParts) == 3 {
-						hours, _ := strconv.Atoi(timestampParts[0])
-						minutes, _ := strconv.Atoi(timestampParts[1])
-						seconds, _ := strconv.Atoi(timestampParts[2])
-						currentSeconds := hours*3600 + minutes*60 + seconds
-						
-						// Parse duration (total length)
-						durationParts := strings.Split(lastEntry.Duration, ":")
-						if len(durationParts) == 3 {
-							durHours, _ := strconv.Atoi(durationParts[0])
-							durMinutes, _ := strconv.Atoi(durationParts[1])
-							durSeconds, _ := strconv.Atoi(durationParts[2])
-							totalSeconds := durHours*3600 + durMinutes*60 + durSeconds
-
-							if totalSeconds > 0 {
-								percentage := (float64(currentSeconds) / float64(totalSeconds)) * 100
-								isComplete = utils.IsEpisodeComplete(percentage)
-							}
-						}
-					}
-				}
-
-				// Calculate which episode to show using shared utility
-				var percentage float64
-				if isComplete {
-					percentage = 100.0
-				}
-				episodeToShow = utils.GetNextEpisode(lastEntry.Progress, lastEntry.EpisodesTotal, percentage)
-				
+		if err == nil {
+			// Use the most recently watched entry
+			if recent := player.RecentHistory(history, 1); len(recent) > 0 {
+				lastEntry := recent[0]
 				return ContinueWatchingAnimeMsg{
-					AnimeName: shortTitle,
-					Episode:   episodeToShow,
+					AnimeName: shortenTitle(lastEntry.Title),
+					Episode:   lastEntry.NextEpisode(),
+					Label:     resumeLabel(lastEntry),
 				}
 			}
 		}
@@ -249,11 +274,15 @@ func (m *MainMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case ContinueWatchingAnimeMsg:
 		m.fetchingAnime = false
+		i := m.continueIndex()
+		if i < 0 {
+			return m, nil
+		}
 		if msg.AnimeName != "" {
-			m.options[0] = fmt.Sprintf("Continue Watching (%s • Episode %d)", msg.AnimeName, msg.Episode)
+			m.options[i] = fmt.Sprintf("Continue Watching (%s • %s)", msg.AnimeName, msg.Label)
 		} else {
 			// No anime found, reset to default
-			m.options[0] = "Continue Watching"
+			m.options[i] = "Continue Watching"
 		}
 		return m, nil
 
@@ -291,13 +320,7 @@ func (m *MainMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case key.Matches(msg, m.keys.Select):
-			// Extract base selection name (remove anime name if present)
-			selected := m.options[m.cursor]
-			if strings.HasPrefix(selected, "Continue Watching") {
-				m.selected = "Continue Watching"
-			} else {
-				m.selected = selected
-			}
+			m.selected = m.items[m.cursor]
 			if m.selected == "Quit" {
 				return m, tea.Quit
 			}
@@ -313,7 +336,7 @@ func (m *MainMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		
 		case key.Matches(msg, m.keys.SelectEpisode):
 			// If on "Continue Watching", 's' key or Shift+Enter opens episode selection
-			if strings.HasPrefix(m.options[m.cursor], "Continue Watching") {
+			if m.cursor == m.continueIndex() {
 				m.selected = "Continue Watching"
 				return m, func() tea.Msg {
 					return MenuSelectionMsg{Selection: m.selected, ShowEpisodeSelect: true}
@@ -328,6 +351,19 @@ func (m *MainMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.styles = IncognitoStyles()
 			} else {
 				m.styles = DefaultStyles()
+				// Private sessions shouldn't linger once incognito is turned off
+				if !m.cfg.Playback.PersistIncognitoSessions {
+					if err := player.DeleteIncognitoHistory(); err != nil {
+						logger.Error("Failed to delete incognito history", err, nil)
+						return m, func() tea.Msg {
+							return ToastMsg{
+								Text: fmt.Sprintf("Failed to delete incognito history: %v", err),
+								Kind: ToastError,
+							}
+						}
+					}
+					logger.Debug("Deleted incognito history", nil)
+				}
 			}
 			// If incognito history is preserved, update continue watching immediately
 			if m.cfg.Playback.PersistIncognitoSessions {
@@ -356,6 +392,9 @@ func (m *MainMenu) View() string {
 	}
 	s := banner + "\n"
 	s += subtitle + "\n\n"
+	if m.offline {
+		s += m.styles.Error.Render("offline — AniList can't be reached, Continue Watching and Recent play from local history") + "\n\n"
+	}
 
 	for i, option := range m.options {
 		cursor := " "
@@ -367,6 +406,9 @@ func (m *MainMenu) View() string {
 		}
 	}
 
+	// Read from cfg on every render, so -w overrides and Settings changes show right away
+	s += "\n" + m.styles.Help.Render(providerStatus(m.cfg)) + "\n"
+
 	if m.err != nil {
 		s += "\n\n" + m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err))
 	}
@@ -379,7 +421,7 @@ func (m *MainMenu) View() string {
 		var viewKeys []key.Binding
 		var viewFull [][]key.Binding
 		
-		if m.cursor < len(m.options) && strings.HasPrefix(m.options[m.cursor], "Continue Watching") {
+		if m.cursor == m.continueIndex() {
 			// Show help with select episode option
 			viewKeys = []key.Binding{m.keys.Up, m.keys.Down, 
 				key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "auto-play")),
@@ -409,6 +451,12 @@ func (m *MainMenu) View() string {
 	return s
 }
 
+// providerStatus describes the active provider, quality and audio, e.g. "provider: aniwatch • 1080 • sub"
+func providerStatus(cfg *config.Config) string {
+	provider := cfg.Provider.Provider
+	return fmt.Sprintf("provider: %s • %s • %s", provider, cfg.QualityFor(provider), cfg.Playback.SubOrDub)
+}
+
 // MenuSelectionMsg is sent when a menu item is selected
 type MenuSelectionMsg struct {
 	Selection        string
@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/player"
+	"github.com/pranshuj73/oni/utils"
+)
+
+func TestShortenTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Re:Zero kara Hajimeru Isekai Seikatsu", "Re:Zero kara Hajimeru Isekai Seikatsu"},
+		{"Fate/Zero", "Fate/Zero"},
+		{"JoJo: Part 3", "JoJo: Part 3"},
+		{"Re: Zero", "Re: Zero"},
+		{"Shingeki no Kyojin: The Final Season", "Shingeki no Kyojin: The Final Season"},
+		{"Sousou no Frieren: Beyond Journey's End", "Sousou no Frieren"},
+		{"Frieren", "Frieren"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := shortenTitle(tt.title); got != tt.want {
+				t.Errorf("shortenTitle(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncognitoHistoryOnDisable(t *testing.T) {
+	tests := []struct {
+		name    string
+		persist bool
+		kept    bool
+	}{
+		{"deleted without persistence", false, false},
+		{"kept with persistence", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("HOME", dir)
+			utils.SetDataDir(dir)
+			t.Cleanup(func() { utils.SetDataDir("") })
+
+			historyPath, err := player.GetHistoryPathWithIncognito(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(historyPath, []byte(`{"version":2,"entries":[]}`), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := config.Default()
+			cfg.Playback.PersistIncognitoSessions = tt.persist
+			menu := NewMainMenu(cfg)
+			toggle := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}
+			menu.Update(toggle) // on
+			menu.Update(toggle) // off
+
+			_, err = os.Stat(historyPath)
+			if kept := err == nil; kept != tt.kept {
+				t.Errorf("incognito history kept = %v, want %v (stat error: %v)", kept, tt.kept, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/utils"
+)
+
+// Size of image previews in terminal cells
+const (
+	previewWidth  = 30
+	previewHeight = episodeListHeight
+)
+
+// previewTimeout bounds downloading one image
+const previewTimeout = 15 * time.Second
+
+// reStreamingEpisode matches the number in AniList streaming episode titles like "Episode 3 - Title"
+var reStreamingEpisode = regexp.MustCompile(`^Episode (\d+)`)
+
+// previewsEnabled reports whether image previews are on and chafa is installed to draw them
+func previewsEnabled(imagePreview bool) bool {
+	if !imagePreview {
+		return false
+	}
+	_, err := exec.LookPath("chafa")
+	return err == nil
+}
+
+// PreviewMsg carries an image drawn as terminal text, keyed by its URL
+type PreviewMsg struct {
+	URL string
+	Art string
+	Err error
+}
+
+// loadPreview downloads an image (or reuses the cached copy) and draws it with chafa
+func loadPreview(url string) tea.Cmd {
+	return func() tea.Msg {
+		path, err := cachedImage(url)
+		if err != nil {
+			return PreviewMsg{URL: url, Err: err}
+		}
+		out, err := exec.Command("chafa", "--format=symbols",
+			fmt.Sprintf("--size=%dx%d", previewWidth, previewHeight), path).Output()
+		if err != nil {
+			return PreviewMsg{URL: url, Err: fmt.Errorf("failed to draw preview: %w", err)}
+		}
+		return PreviewMsg{URL: url, Art: strings.TrimRight(string(out), "\n")}
+	}
+}
+
+// cachedImage returns the path of a downloaded image under the cache directory, fetching it the first time
+func cachedImage(url string) (string, error) {
+	cacheDir, err := utils.CacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(cacheDir, "thumbnails")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create thumbnail directory: %w", err)
+	}
+	sum := sha1.Sum([]byte(url))
+	path := filepath.Join(dir, hex.EncodeToString(sum[:]))
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create image request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download image: status %d", resp.StatusCode)
+	}
+
+	// Write to a temporary file first so a failed download never leaves a broken image cached
+	tmp, err := os.CreateTemp(dir, "download-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create image file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to save image: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to save image: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to save image: %w", err)
+	}
+	return path, nil
+}
+
+// EpisodeThumbnailsMsg maps AniList episode numbers to thumbnail URLs
+type EpisodeThumbnailsMsg struct {
+	Thumbnails map[int]string
+}
+
+// fetchEpisodeThumbnails looks up AniList's streaming episodes for their thumbnails
+func fetchEpisodeThumbnails(mediaID int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+		defer cancel()
+
+		episodes, err := anilist.NewPublicClient().GetStreamingEpisodes(ctx, mediaID)
+		if err != nil {
+			logger.Debug("No episode thumbnails", map[string]interface{}{
+				"mediaID": mediaID,
+				"error":   err.Error(),
+			})
+			return EpisodeThumbnailsMsg{}
+		}
+
+		thumbnails := make(map[int]string)
+		for _, ep := range episodes {
+			match := reStreamingEpisode.FindStringSubmatch(ep.Title)
+			if match == nil || ep.Thumbnail == "" {
+				continue
+			}
+			if number, err := strconv.Atoi(match[1]); err == nil {
+				thumbnails[number] = ep.Thumbnail
+			}
+		}
+		return EpisodeThumbnailsMsg{Thumbnails: thumbnails}
+	}
+}
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pranshuj73/oni/config"
+)
+
+// QualitySelect lets the user pick one of the qualities a provider resolved
+type QualitySelect struct {
+	cfg           *config.Config
+	styles        Styles
+	help          help.Model
+	title         string
+	qualities     []string
+	cursor        int
+	universalKeys UniversalKeys
+}
+
+// QualitySelectedMsg is sent when the user picks a quality
+type QualitySelectedMsg struct {
+	Quality string
+}
+
+// NewQualitySelect creates a new quality picker; qualities should be ordered best first
+func NewQualitySelect(cfg *config.Config, title string, qualities []string) *QualitySelect {
+	m := &QualitySelect{
+		cfg:           cfg,
+		styles:        DefaultStyles(),
+		help:          help.New(),
+		title:         title,
+		qualities:     qualities,
+		cursor:        0,
+		universalKeys: DefaultUniversalKeys(),
+	}
+	m.help.ShowAll = false
+	return m
+}
+
+func (m *QualitySelect) Init() tea.Cmd {
+	return nil
+}
+
+func (m *QualitySelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Handle universal keys
+		switch {
+		case key.Matches(msg, m.universalKeys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+		case key.Matches(msg, m.universalKeys.Quit):
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.qualities)-1 {
+				m.cursor++
+			}
+		case "enter":
+			if len(m.qualities) > 0 {
+				quality := m.qualities[m.cursor]
+				return m, func() tea.Msg {
+					return QualitySelectedMsg{Quality: quality}
+				}
+			}
+		case "backspace":
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+	}
+
+	return m, nil
+}
+
+func (m *QualitySelect) View() string {
+	s := "\n"
+	s += m.styles.Title.Render(m.title) + "\n\n"
+	s += m.styles.Prompt.Render("Select quality:") + "\n"
+
+	for i, quality := range m.qualities {
+		label := quality
+		if _, err := strconv.Atoi(quality); err == nil {
+			label = quality + "p"
+		}
+
+		if m.cursor == i {
+			s += m.styles.SelectedItem.Render("> "+label) + "\n"
+		} else {
+			s += m.styles.MenuItem.Render("  "+label) + "\n"
+		}
+	}
+
+	helpKeys := qualitySelectKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "move down"),
+		),
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "play"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back"),
+		),
+	}
+
+	extendedKeys := ExtendedKeyMap{
+		Universal: m.universalKeys,
+		ViewKeys:  helpKeys.ShortHelp(),
+		ViewFull:  helpKeys.FullHelp(),
+	}
+
+	s += "\n" + m.help.View(extendedKeys)
+	return s
+}
+
+// qualitySelectKeyMap defines the keybindings for the quality picker
+type qualitySelectKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Enter key.Binding
+	Back  key.Binding
+}
+
+func (k qualitySelectKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Enter, k.Back}
+}
+
+func (k qualitySelectKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Enter},
+		{k.Back},
+	}
+}
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/player"
+)
+
+// recentLimit is the number of distinct anime shown in the recent picker
+const recentLimit = 5
+
+// RecentSelect lets the user pick which recently watched anime to resume
+type RecentSelect struct {
+	cfg           *config.Config
+	styles        Styles
+	help          help.Model
+	incognito     bool
+	entries       []player.HistoryEntry
+	loaded        bool
+	err           error
+	cursor        int
+	universalKeys UniversalKeys
+}
+
+// RecentHistoryMsg is sent when the recent history has been loaded
+type RecentHistoryMsg struct {
+	Entries []player.HistoryEntry
+	Err     error
+}
+
+// RecentSelectedMsg is sent when the user picks an anime to resume
+type RecentSelectedMsg struct {
+	Entry             player.HistoryEntry
+	ShowEpisodeSelect bool
+}
+
+// NewRecentSelect creates a new recent picker for normal or incognito history
+func NewRecentSelect(cfg *config.Config, incognito bool) *RecentSelect {
+	m := &RecentSelect{
+		cfg:           cfg,
+		styles:        DefaultStyles(),
+		help:          help.New(),
+		incognito:     incognito,
+		universalKeys: DefaultUniversalKeys(),
+	}
+	if incognito {
+		m.styles = IncognitoStyles()
+	}
+	m.help.ShowAll = false
+	return m
+}
+
+// Init loads the recent history
+func (m *RecentSelect) Init() tea.Cmd {
+	incognito := m.incognito
+	return func() tea.Msg {
+		history, err := player.LoadHistoryWithIncognito(incognito)
+		if err != nil {
+			return RecentHistoryMsg{Err: err}
+		}
+		return RecentHistoryMsg{Entries: player.RecentHistory(history, recentLimit)}
+	}
+}
+
+// Update handles messages
+func (m *RecentSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case RecentHistoryMsg:
+		m.loaded = true
+		m.entries = msg.Entries
+		m.err = msg.Err
+		m.cursor = 0
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.universalKeys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+		case key.Matches(msg, m.universalKeys.Quit):
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.entries)-1 {
+				m.cursor++
+			}
+		case "enter", "s":
+			if len(m.entries) > 0 {
+				entry := m.entries[m.cursor]
+				showEpisodeSelect := msg.String() == "s"
+				return m, func() tea.Msg {
+					return RecentSelectedMsg{Entry: entry, ShowEpisodeSelect: showEpisodeSelect}
+				}
+			}
+		case "backspace":
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the recent picker
+func (m *RecentSelect) View() string {
+	s := "\n"
+	s += m.styles.Title.Render("Recently Watched") + "\n\n"
+
+	backKeys := backOnlyHelpKeyMap{
+		Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	}
+
+	switch {
+	case !m.loaded:
+		s += m.styles.Info.Render("Loading history...") + "\n"
+		return s
+	case m.err != nil:
+		s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+		s += m.help.View(backKeys)
+		return s
+	case len(m.entries) == 0:
+		s += m.styles.Info.Render("Nothing watched yet") + "\n\n"
+		s += m.help.View(backKeys)
+		return s
+	}
+
+	for i, entry := range m.entries {
+		label := fmt.Sprintf("%s • %s", shortenTitle(entry.Title), resumeLabel(entry))
+		if m.cursor == i {
+			s += m.styles.SelectedItem.Render("> "+label) + "\n"
+		} else {
+			s += m.styles.MenuItem.Render("  "+label) + "\n"
+		}
+	}
+
+	helpKeys := recentSelectKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "move down"),
+		),
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "resume"),
+		),
+		SelectEpisode: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "select episode"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back"),
+		),
+	}
+
+	extendedKeys := ExtendedKeyMap{
+		Universal: m.universalKeys,
+		ViewKeys:  helpKeys.ShortHelp(),
+		ViewFull:  helpKeys.FullHelp(),
+	}
+
+	s += "\n" + m.help.View(extendedKeys)
+	return s
+}
+
+// recentSelectKeyMap defines the keybindings for the recent picker
+type recentSelectKeyMap struct {
+	Up            key.Binding
+	Down          key.Binding
+	Enter         key.Binding
+	SelectEpisode key.Binding
+	Back          key.Binding
+}
+
+func (k recentSelectKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Enter, k.SelectEpisode, k.Back}
+}
+
+func (k recentSelectKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Enter, k.SelectEpisode},
+		{k.Back},
+	}
+}
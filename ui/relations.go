@@ -0,0 +1,240 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/utils"
+)
+
+// relationOrder lists the relation types worth watching next, sequels first
+var relationOrder = []string{"SEQUEL", "PREQUEL", "PARENT", "SIDE_STORY", "SPIN_OFF", "ALTERNATIVE", "SUMMARY"}
+
+// relationLabels maps AniList relation types to display labels
+var relationLabels = map[string]string{
+	"SEQUEL":      "Sequel",
+	"PREQUEL":     "Prequel",
+	"PARENT":      "Parent Story",
+	"SIDE_STORY":  "Side Story",
+	"SPIN_OFF":    "Spin-off",
+	"ALTERNATIVE": "Alternative",
+	"SUMMARY":     "Summary",
+}
+
+// Relations lists an anime's sequels, prequels and side stories
+type Relations struct {
+	cfg           *config.Config
+	client        *anilist.Client
+	styles        Styles
+	anime         anilist.Anime
+	loading       bool
+	related       []anilist.RelationEdge
+	cursor        int
+	err           error
+	spinner       spinner.Model
+	help          help.Model
+	universalKeys UniversalKeys
+}
+
+// NewRelations creates the related-anime view for an anime
+func NewRelations(cfg *config.Config, client *anilist.Client, anime anilist.Anime) *Relations {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("5"))
+
+	h := help.New()
+	h.ShowAll = false
+
+	return &Relations{
+		cfg:           cfg,
+		client:        client,
+		styles:        DefaultStyles(),
+		anime:         anime,
+		loading:       true,
+		spinner:       s,
+		help:          h,
+		universalKeys: DefaultUniversalKeys(),
+	}
+}
+
+// RelationsResultMsg is sent when the related entries are loaded
+type RelationsResultMsg struct {
+	Related []anilist.RelationEdge
+	Err     error
+}
+
+// Init starts loading the related entries
+func (m *Relations) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.fetchRelations)
+}
+
+// fetchRelations loads the anime's relations and keeps the watchable ones in display order
+func (m *Relations) fetchRelations() tea.Msg {
+	if m.client == nil {
+		return RelationsResultMsg{Err: fmt.Errorf("AniList is not configured")}
+	}
+	info, err := m.client.GetAnimeInfo(context.Background(), m.anime.ID)
+	if err != nil {
+		return RelationsResultMsg{Err: err}
+	}
+	if info.Relations == nil {
+		return RelationsResultMsg{}
+	}
+
+	var related []anilist.RelationEdge
+	for _, relationType := range relationOrder {
+		for _, edge := range info.Relations.Edges {
+			if edge.RelationType == relationType && edge.Node.Type == "ANIME" {
+				related = append(related, edge)
+			}
+		}
+	}
+	return RelationsResultMsg{Related: related}
+}
+
+// Update handles messages
+func (m *Relations) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+
+	case RelationsResultMsg:
+		m.loading = false
+		m.related = msg.Related
+		m.err = msg.Err
+		m.cursor = 0
+		return m, nil
+
+	case AddToPlanningResultMsg:
+		return m, planningResultToast(msg)
+
+	case tea.KeyMsg:
+		if key.Matches(msg, m.universalKeys.Help) {
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "esc", "q", "backspace":
+			return m, func() tea.Msg { return BackMsg{} }
+
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < len(m.related)-1 {
+				m.cursor++
+			}
+
+		case "enter":
+			if len(m.related) > 0 {
+				anime := m.related[m.cursor].Node.Anime
+				return m, func() tea.Msg {
+					return AnimeSelectedMsg{
+						Anime:             anime,
+						ShowEpisodeSelect: true,
+					}
+				}
+			}
+
+		case "a":
+			if len(m.related) > 0 {
+				return m, addToPlanning(m.cfg, m.client, m.related[m.cursor].Node.Anime)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the related entries
+func (m *Relations) View() string {
+	s := m.styles.Title.Render("Related to "+m.anime.Title.UserPreferred) + "\n\n"
+
+	backKeys := backOnlyHelpKeyMap{
+		Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	}
+
+	if m.loading {
+		s += fmt.Sprintf("%s %s\n", m.spinner.View(), m.styles.Info.Render("Loading related anime..."))
+		return s
+	}
+
+	if m.err != nil {
+		s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n"
+		s += m.help.View(backKeys)
+		return s
+	}
+
+	if len(m.related) == 0 {
+		s += m.styles.Info.Render("No sequels, prequels or side stories found") + "\n"
+		s += m.help.View(backKeys)
+		return s
+	}
+
+	for i, edge := range m.related {
+		cursor := " "
+		title := fmt.Sprintf("[%s] %s", relationLabels[edge.RelationType], edge.Node.Title.UserPreferred)
+
+		// Add episode count if available
+		if edge.Node.Episodes != nil {
+			title = fmt.Sprintf("%s (%d episodes, %s)", title, *edge.Node.Episodes, utils.FormatWatchTime(edge.Node.WatchMinutes()))
+		}
+
+		// Add start year if available
+		if edge.Node.StartDate.Year != nil {
+			title = fmt.Sprintf("%s [%d]", title, *edge.Node.StartDate.Year)
+		}
+
+		if m.cursor == i {
+			cursor = ">"
+			s += m.styles.SelectedItem.Render(cursor+" "+title) + "\n"
+		} else {
+			s += m.styles.MenuItem.Render(cursor+" "+title) + "\n"
+		}
+	}
+
+	keys := relationsHelpKeyMap{
+		Up:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Down:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "watch")),
+		Plan:   key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add to planning")),
+		Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	}
+	s += "\n" + m.help.View(keys)
+	return s
+}
+
+// relationsHelpKeyMap for the related anime help
+type relationsHelpKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Plan   key.Binding
+	Back   key.Binding
+}
+
+func (k relationsHelpKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Select, k.Plan, k.Back}
+}
+
+func (k relationsHelpKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Select, k.Plan, k.Back},
+	}
+}
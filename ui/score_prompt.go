@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/logger"
+)
+
+// scoreFormats maps AniList score formats to their maximum score and whether decimals are allowed
+var scoreFormats = map[string]struct {
+	max     float64
+	decimal bool
+}{
+	"POINT_100":        {100, false},
+	"POINT_10_DECIMAL": {10, true},
+	"POINT_10":         {10, false},
+	"POINT_5":          {5, false},
+	"POINT_3":          {3, false},
+}
+
+// ScorePrompt asks the user to score an anime after finishing it
+type ScorePrompt struct {
+	cfg           *config.Config
+	client        *anilist.Client
+	styles        Styles
+	help          help.Model
+	mediaID       int
+	animeTitle    string
+	scoreFormat   string
+	loaded        bool
+	saving        bool
+	inputValue    string
+	err           error
+	universalKeys UniversalKeys
+}
+
+// ScoreFormatMsg is sent when the user's score format has been fetched
+type ScoreFormatMsg struct {
+	Format string
+}
+
+// ScoreSavedMsg is sent when the score has been saved to AniList
+type ScoreSavedMsg struct {
+	Score float64
+	Err   error
+}
+
+// NewScorePrompt creates a new score prompt for a completed anime
+func NewScorePrompt(cfg *config.Config, client *anilist.Client, mediaID int, animeTitle string) *ScorePrompt {
+	m := &ScorePrompt{
+		cfg:           cfg,
+		client:        client,
+		styles:        DefaultStyles(),
+		help:          help.New(),
+		mediaID:       mediaID,
+		animeTitle:    animeTitle,
+		scoreFormat:   "POINT_100",
+		universalKeys: DefaultUniversalKeys(),
+	}
+	m.help.ShowAll = false
+	return m
+}
+
+// Init fetches the user's score format
+func (m *ScorePrompt) Init() tea.Cmd {
+	return func() tea.Msg {
+		format, err := m.client.GetScoreFormat(context.Background())
+		if err != nil {
+			logger.Warn("Failed to fetch score format, assuming POINT_100", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return ScoreFormatMsg{Format: "POINT_100"}
+		}
+		return ScoreFormatMsg{Format: format}
+	}
+}
+
+// parseScore validates the input against the user's score format
+func (m *ScorePrompt) parseScore() (float64, error) {
+	format := scoreFormats[m.scoreFormat]
+	score, err := strconv.ParseFloat(m.inputValue, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid score")
+	}
+	if score < 0 || score > format.max {
+		return 0, fmt.Errorf("score must be between 0 and %g", format.max)
+	}
+	return score, nil
+}
+
+// saveScore saves the score to AniList
+func (m *ScorePrompt) saveScore(score float64) tea.Cmd {
+	return func() tea.Msg {
+		err := m.client.UpdateScore(context.Background(), m.mediaID, score)
+		if err == nil {
+			ForceRefreshCacheInBackground(m.cfg, m.client)
+		}
+		return ScoreSavedMsg{Score: score, Err: err}
+	}
+}
+
+// Update handles messages
+func (m *ScorePrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ScoreFormatMsg:
+		if _, ok := scoreFormats[msg.Format]; ok {
+			m.scoreFormat = msg.Format
+		}
+		m.loaded = true
+		return m, nil
+
+	case ScoreSavedMsg:
+		m.saving = false
+		if msg.Err != nil {
+			m.err = msg.Err
+			return m, func() tea.Msg {
+				return ToastMsg{
+					Text: fmt.Sprintf("Failed to save score: %v", msg.Err),
+					Kind: ToastError,
+				}
+			}
+		}
+		return m, tea.Batch(
+			func() tea.Msg {
+				return ToastMsg{
+					Text: fmt.Sprintf("Scored %s %g", m.animeTitle, msg.Score),
+					Kind: ToastSuccess,
+				}
+			},
+			func() tea.Msg { return BackMsg{} },
+		)
+
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+
+	case tea.KeyMsg:
+		if m.saving {
+			return m, nil
+		}
+
+		// q is ignored like any other letter so a stray key doesn't skip the prompt; esc leaves
+		switch {
+		case key.Matches(msg, m.universalKeys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+		case msg.String() == "esc":
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+
+		switch msg.String() {
+		case "backspace":
+			if len(m.inputValue) == 0 {
+				return m, func() tea.Msg { return BackMsg{} }
+			}
+			m.inputValue = m.inputValue[:len(m.inputValue)-1]
+			m.err = nil
+
+		case "enter":
+			if m.inputValue == "" {
+				return m, nil
+			}
+			score, err := m.parseScore()
+			if err != nil {
+				m.err = err
+				return m, nil
+			}
+			m.saving = true
+			return m, m.saveScore(score)
+
+		default:
+			// Accept numeric input, plus a decimal point for decimal formats
+			extra := ""
+			if scoreFormats[m.scoreFormat].decimal {
+				extra = "."
+			}
+			if input := appendDigits(m.inputValue, msg, extra); input != m.inputValue {
+				m.inputValue = input
+				m.err = nil
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the score prompt
+func (m *ScorePrompt) View() string {
+	s := "\n"
+	s += m.styles.Title.Render(fmt.Sprintf("Finished %s!", m.animeTitle)) + "\n\n"
+
+	if !m.loaded {
+		s += m.styles.Info.Render("Loading score format...") + "\n"
+		return s
+	}
+
+	s += m.styles.Prompt.Render(fmt.Sprintf("Enter your score (0-%g):", scoreFormats[m.scoreFormat].max)) + "\n"
+	s += m.styles.MenuItem.Render(m.inputValue+"█") + "\n"
+
+	if m.saving {
+		s += m.styles.Info.Render("Saving...") + "\n"
+	} else if m.err != nil {
+		s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n"
+	}
+
+	helpKeys := scorePromptKeyMap{
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "save score"),
+		),
+		Skip: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "skip"),
+		),
+	}
+
+	extendedKeys := ExtendedKeyMap{
+		Universal: m.universalKeys,
+		ViewKeys:  helpKeys.ShortHelp(),
+		ViewFull:  helpKeys.FullHelp(),
+	}
+
+	s += "\n" + m.help.View(extendedKeys)
+	return s
+}
+
+// scorePromptKeyMap defines the keybindings for the score prompt
+type scorePromptKeyMap struct {
+	Enter key.Binding
+	Skip  key.Binding
+}
+
+func (k scorePromptKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Enter, k.Skip}
+}
+
+func (k scorePromptKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Enter, k.Skip}}
+}
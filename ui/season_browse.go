@@ -0,0 +1,412 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/config"
+)
+
+// SeasonBrowseState represents the season browse state
+type SeasonBrowseState int
+
+const (
+	SeasonPick SeasonBrowseState = iota
+	SeasonLoading
+	SeasonResults
+)
+
+// seasons in AniList MediaSeason order
+var seasons = []string{"WINTER", "SPRING", "SUMMER", "FALL"}
+
+// seasonLabels maps AniList seasons to display labels
+var seasonLabels = map[string]string{
+	"WINTER": "Winter",
+	"SPRING": "Spring",
+	"SUMMER": "Summer",
+	"FALL":   "Fall",
+}
+
+// currentSeason returns the AniList season index for a given month
+func currentSeason(month time.Month) int {
+	switch {
+	case month <= time.March:
+		return 0
+	case month <= time.June:
+		return 1
+	case month <= time.September:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// SeasonBrowse represents the seasonal browse model
+type SeasonBrowse struct {
+	cfg           *config.Config
+	client        *anilist.Client
+	styles        Styles
+	state         SeasonBrowseState
+	seasonIndex   int
+	year          int
+	page          int
+	hasNextPage   bool
+	fetchID       int // Bumped on each fetch so results of a cancelled one are dropped
+	cursor        int
+	results       []anilist.Anime
+	err           error
+	spinner       spinner.Model
+	help          help.Model
+	universalKeys UniversalKeys
+}
+
+// NewSeasonBrowse creates a new season browser starting at the current season
+func NewSeasonBrowse(cfg *config.Config, client *anilist.Client) *SeasonBrowse {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("5"))
+
+	h := help.New()
+	h.ShowAll = false
+
+	now := time.Now()
+	return &SeasonBrowse{
+		cfg:           cfg,
+		client:        client,
+		styles:        DefaultStyles(),
+		state:         SeasonPick,
+		seasonIndex:   currentSeason(now.Month()),
+		year:          now.Year(),
+		page:          1,
+		spinner:       s,
+		help:          h,
+		universalKeys: DefaultUniversalKeys(),
+	}
+}
+
+// Init initializes the season browser
+func (m *SeasonBrowse) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+// SeasonResultMsg is sent when a page of seasonal anime is ready
+type SeasonResultMsg struct {
+	Results     []anilist.Anime
+	Page        int
+	HasNextPage bool
+	Err         error
+	FetchID     int
+}
+
+// AddToPlanningResultMsg is sent when an anime has been added to the Planning list
+type AddToPlanningResultMsg struct {
+	Title string
+	Err   error
+}
+
+// fetchSeason fetches the given page of the selected season
+func (m *SeasonBrowse) fetchSeason(page int) tea.Cmd {
+	season := seasons[m.seasonIndex]
+	year := m.year
+	m.fetchID++
+	fetchID := m.fetchID
+	return func() tea.Msg {
+		if m.client == nil {
+			return SeasonResultMsg{Page: page, Err: fmt.Errorf("AniList is not configured"), FetchID: fetchID}
+		}
+		results, hasNext, err := m.client.GetSeasonalAnime(context.Background(), season, year, page, m.cfg.Advanced.ShowAdultContent)
+		return SeasonResultMsg{Results: results, Page: page, HasNextPage: hasNext, Err: err, FetchID: fetchID}
+	}
+}
+
+// addToPlanning adds the anime to the user's Planning list
+// Shared by every screen that offers the quick-add; the result comes back as AddToPlanningResultMsg
+func addToPlanning(cfg *config.Config, client *anilist.Client, anime anilist.Anime) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return AddToPlanningResultMsg{Title: anime.Title.UserPreferred, Err: fmt.Errorf("AniList is not configured")}
+		}
+		err := client.UpdateStatus(context.Background(), anime.ID, "PLANNING")
+		if err == nil {
+			ForceRefreshCacheInBackground(cfg, client)
+		}
+		return AddToPlanningResultMsg{Title: anime.Title.UserPreferred, Err: err}
+	}
+}
+
+// planningResultToast confirms an addToPlanning result with a toast
+func planningResultToast(msg AddToPlanningResultMsg) tea.Cmd {
+	if msg.Err != nil {
+		return func() tea.Msg {
+			return ToastMsg{
+				Text: fmt.Sprintf("Failed to add %s to Planning: %v", msg.Title, msg.Err),
+				Kind: ToastError,
+			}
+		}
+	}
+	return func() tea.Msg {
+		return ToastMsg{
+			Text: fmt.Sprintf("Added %s to Planning", msg.Title),
+			Kind: ToastSuccess,
+		}
+	}
+}
+
+// Update handles messages
+func (m *SeasonBrowse) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+
+	case SeasonResultMsg:
+		// Ignore a fetch that was cancelled or replaced while it ran
+		if m.state != SeasonLoading || msg.FetchID != m.fetchID {
+			return m, nil
+		}
+		m.state = SeasonResults
+		m.err = msg.Err
+		if msg.Err == nil {
+			m.results = msg.Results
+			m.page = msg.Page
+			m.hasNextPage = msg.HasNextPage
+			m.cursor = 0
+		}
+		return m, nil
+
+	case AddToPlanningResultMsg:
+		return m, planningResultToast(msg)
+
+	case tea.KeyMsg:
+		if key.Matches(msg, m.universalKeys.Help) {
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+		}
+
+		switch m.state {
+		case SeasonLoading:
+			switch msg.String() {
+			case "esc", "backspace":
+				// Cancel a slow or hung query and go back to season selection
+				m.state = SeasonPick
+				m.err = nil
+				return m, nil
+			}
+
+		case SeasonPick:
+			switch msg.String() {
+			case "ctrl+c", "esc", "q", "backspace":
+				return m, func() tea.Msg { return BackMsg{} }
+
+			case "left", "h":
+				if m.seasonIndex > 0 {
+					m.seasonIndex--
+				} else {
+					m.seasonIndex = len(seasons) - 1
+					m.year--
+				}
+
+			case "right", "l":
+				if m.seasonIndex < len(seasons)-1 {
+					m.seasonIndex++
+				} else {
+					m.seasonIndex = 0
+					m.year++
+				}
+
+			case "up", "k":
+				m.year++
+
+			case "down", "j":
+				m.year--
+
+			case "enter":
+				m.state = SeasonLoading
+				return m, m.fetchSeason(1)
+			}
+
+		case SeasonResults:
+			switch msg.String() {
+			case "ctrl+c", "q":
+				return m, func() tea.Msg { return BackMsg{} }
+
+			case "esc", "backspace":
+				// Go back to season selection
+				m.state = SeasonPick
+				m.err = nil
+				return m, nil
+
+			case "up", "k":
+				if m.cursor > 0 {
+					m.cursor--
+				}
+
+			case "down", "j":
+				if m.cursor < len(m.results)-1 {
+					m.cursor++
+				}
+
+			case "right", "l", "]":
+				if m.hasNextPage {
+					m.state = SeasonLoading
+					return m, m.fetchSeason(m.page + 1)
+				}
+
+			case "left", "h", "[":
+				if m.page > 1 {
+					m.state = SeasonLoading
+					return m, m.fetchSeason(m.page - 1)
+				}
+
+			case "enter":
+				if len(m.results) > 0 {
+					anime := m.results[m.cursor]
+					return m, func() tea.Msg {
+						return AnimeSelectedMsg{
+							Anime:             anime,
+							ShowEpisodeSelect: true,
+						}
+					}
+				}
+
+			case "a":
+				if len(m.results) > 0 {
+					return m, addToPlanning(m.cfg, m.client, m.results[m.cursor])
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the season browser
+func (m *SeasonBrowse) View() string {
+	seasonLabel := fmt.Sprintf("%s %d", seasonLabels[seasons[m.seasonIndex]], m.year)
+
+	switch m.state {
+	case SeasonPick:
+		s := m.styles.Title.Render("Browse Season") + "\n\n"
+		s += m.styles.Prompt.Render("Season:") + "\n"
+		s += m.styles.SelectedItem.Render(fmt.Sprintf("< %s >", seasonLabel)) + "\n\n"
+
+		keys := seasonPickHelpKeyMap{
+			Season: key.NewBinding(key.WithKeys("left", "right"), key.WithHelp("←/→", "season")),
+			Year:   key.NewBinding(key.WithKeys("up", "down"), key.WithHelp("↑/↓", "year")),
+			Enter:  key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "browse")),
+			Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		}
+		s += m.help.View(keys)
+		return s
+
+	case SeasonLoading:
+		s := m.styles.Title.Render("Browse Season") + "\n\n"
+		s += fmt.Sprintf("%s %s\n\n", m.spinner.View(), m.styles.Info.Render(fmt.Sprintf("Loading %s...", seasonLabel)))
+		s += m.help.View(backOnlyHelpKeyMap{
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		})
+		return s
+
+	case SeasonResults:
+		s := m.styles.Title.Render(fmt.Sprintf("%s • Page %d", seasonLabel, m.page)) + "\n\n"
+
+		backKeys := backOnlyHelpKeyMap{
+			Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		}
+
+		if m.err != nil {
+			s += m.styles.Error.Render(fmt.Sprintf("Error: %v", m.err)) + "\n"
+			s += m.help.View(backKeys)
+			return s
+		}
+
+		if len(m.results) == 0 {
+			s += m.styles.Info.Render("No anime found for this season") + "\n"
+			s += m.help.View(backKeys)
+			return s
+		}
+
+		for i, anime := range m.results {
+			cursor := " "
+			title := anime.Title.UserPreferred
+
+			// Add episode count if available
+			if anime.Episodes != nil {
+				title = fmt.Sprintf("%s (%d episodes)", title, *anime.Episodes)
+			}
+
+			// Add average score if available
+			if anime.AverageScore != nil {
+				title = fmt.Sprintf("%s [%d%%]", title, *anime.AverageScore)
+			}
+
+			if m.cursor == i {
+				cursor = ">"
+				s += m.styles.SelectedItem.Render(cursor+" "+title) + "\n"
+			} else {
+				s += m.styles.MenuItem.Render(cursor+" "+title) + "\n"
+			}
+		}
+
+		keys := seasonResultsHelpKeyMap{
+			Up:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+			Down:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+			Page:   key.NewBinding(key.WithKeys("left", "right"), key.WithHelp("←/→", "page")),
+			Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "watch")),
+			Plan:   key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add to planning")),
+			Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+		}
+		s += "\n" + m.help.View(keys)
+		return s
+	}
+
+	return ""
+}
+
+// seasonPickHelpKeyMap for season selection help
+type seasonPickHelpKeyMap struct {
+	Season key.Binding
+	Year   key.Binding
+	Enter  key.Binding
+	Back   key.Binding
+}
+
+func (k seasonPickHelpKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Season, k.Year, k.Enter, k.Back}
+}
+
+func (k seasonPickHelpKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Season, k.Year, k.Enter, k.Back}}
+}
+
+// seasonResultsHelpKeyMap for season results help
+type seasonResultsHelpKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Page   key.Binding
+	Select key.Binding
+	Plan   key.Binding
+	Back   key.Binding
+}
+
+func (k seasonResultsHelpKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Page, k.Select, k.Plan, k.Back}
+}
+
+func (k seasonResultsHelpKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Page},
+		{k.Select, k.Plan, k.Back},
+	}
+}
@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pranshuj73/oni/anilist"
+	"github.com/pranshuj73/oni/logger"
+	"github.com/pranshuj73/oni/utils"
+)
+
+// Screens recorded in the session file
+const (
+	SessionMainMenu  = "main_menu"
+	SessionAnimeList = "anime_list"
+)
+
+// Session is the UI position saved on exit and restored at startup when restore_session is enabled
+type Session struct {
+	Screen          string `json:"screen"`
+	TabIndex        int    `json:"tab_index"`
+	SelectedMediaID int    `json:"selected_media_id"`
+}
+
+// getSessionPath returns the path to the session file
+func getSessionPath() (string, error) {
+	cacheDir, err := utils.CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "session.json"), nil
+}
+
+// LoadSession reads the saved session and applies its list position
+// Returns nil when there is no usable session
+func LoadSession() *Session {
+	sessionPath, err := getSessionPath()
+	if err != nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(sessionPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Warn("Failed to read session file", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		return nil
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		logger.Warn("Ignoring corrupt session file", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	lastTabIndex = session.TabIndex
+	lastSelectedMediaID = session.SelectedMediaID
+
+	logger.Debug("Loaded session", map[string]interface{}{
+		"screen":          session.Screen,
+		"tabIndex":        session.TabIndex,
+		"selectedMediaID": session.SelectedMediaID,
+	})
+	return &session
+}
+
+// SaveSession records the current screen together with the anime list's tab and cursor
+// model is the screen on display, used to pick up the highlighted show in the list
+func SaveSession(screen string, model interface{}) error {
+	if animeList, ok := model.(*AnimeList); ok {
+		lastTabIndex = animeList.tabIndex
+		if entry := animeList.GetSelectedEntry(); entry != nil {
+			lastSelectedMediaID = entry.Media.ID
+		}
+	}
+
+	data, err := json.Marshal(Session{
+		Screen:          screen,
+		TabIndex:        lastTabIndex,
+		SelectedMediaID: lastSelectedMediaID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	sessionPath, err := getSessionPath()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(sessionPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+	return nil
+}
+
+// AutoplaySession is the autoplay run in progress, kept so it can be continued after a restart
+type AutoplaySession struct {
+	Anime    anilist.Anime `json:"anime"`
+	Episode  int           `json:"episode"` // Last episode finished in the run
+	SubOrDub string        `json:"sub_or_dub"`
+}
+
+// getAutoplaySessionPath returns the path to the autoplay session file
+func getAutoplaySessionPath() (string, error) {
+	cacheDir, err := utils.CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "autoplay.json"), nil
+}
+
+// LoadAutoplaySession reads the saved autoplay run
+// Returns nil when there is none
+func LoadAutoplaySession() *AutoplaySession {
+	sessionPath, err := getAutoplaySessionPath()
+	if err != nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(sessionPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Warn("Failed to read autoplay session file", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		return nil
+	}
+
+	var session AutoplaySession
+	if err := json.Unmarshal(data, &session); err != nil || session.Anime.ID == 0 {
+		logger.Warn("Ignoring corrupt autoplay session file", nil)
+		return nil
+	}
+	return &session
+}
+
+// SaveAutoplaySession records the autoplay run in progress
+func SaveAutoplaySession(session AutoplaySession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal autoplay session: %w", err)
+	}
+
+	sessionPath, err := getAutoplaySessionPath()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(sessionPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write autoplay session file: %w", err)
+	}
+	return nil
+}
+
+// ClearAutoplaySession forgets the saved autoplay run
+func ClearAutoplaySession() error {
+	sessionPath, err := getAutoplaySessionPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(sessionPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove autoplay session file: %w", err)
+	}
+	return nil
+}
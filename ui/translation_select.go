@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pranshuj73/oni/config"
+	"github.com/pranshuj73/oni/providers"
+)
+
+// TranslationSelect lets the user pick one of the voiceover tracks a provider offers
+type TranslationSelect struct {
+	cfg           *config.Config
+	styles        Styles
+	help          help.Model
+	title         string
+	translations  []providers.Translation
+	cursor        int
+	universalKeys UniversalKeys
+}
+
+// TranslationSelectedMsg is sent when the user picks a translation
+type TranslationSelectedMsg struct {
+	Translation providers.Translation
+}
+
+// NewTranslationSelect creates a new translation picker; the provider's default should be first
+func NewTranslationSelect(cfg *config.Config, title string, translations []providers.Translation) *TranslationSelect {
+	m := &TranslationSelect{
+		cfg:           cfg,
+		styles:        DefaultStyles(),
+		help:          help.New(),
+		title:         title,
+		translations:  translations,
+		cursor:        0,
+		universalKeys: DefaultUniversalKeys(),
+	}
+	m.help.ShowAll = false
+	return m
+}
+
+func (m *TranslationSelect) Init() tea.Cmd {
+	return nil
+}
+
+func (m *TranslationSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Handle universal keys
+		switch {
+		case key.Matches(msg, m.universalKeys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+			return m, nil
+		case key.Matches(msg, m.universalKeys.Quit):
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.translations)-1 {
+				m.cursor++
+			}
+		case "enter":
+			if len(m.translations) > 0 {
+				translation := m.translations[m.cursor]
+				return m, func() tea.Msg {
+					return TranslationSelectedMsg{Translation: translation}
+				}
+			}
+		case "backspace":
+			return m, func() tea.Msg { return BackMsg{} }
+		}
+
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+	}
+
+	return m, nil
+}
+
+func (m *TranslationSelect) View() string {
+	s := "\n"
+	s += m.styles.Title.Render(m.title) + "\n\n"
+	s += m.styles.Prompt.Render("Select voiceover:") + "\n"
+
+	for i, translation := range m.translations {
+		label := translation.Name
+		if i == 0 {
+			label += " (default)"
+		}
+
+		if m.cursor == i {
+			s += m.styles.SelectedItem.Render("> "+label) + "\n"
+		} else {
+			s += m.styles.MenuItem.Render("  "+label) + "\n"
+		}
+	}
+	s += "\n" + m.styles.Help.Render("Your choice is remembered for this anime.") + "\n"
+
+	helpKeys := qualitySelectKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "move down"),
+		),
+		Enter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "select"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back"),
+		),
+	}
+
+	extendedKeys := ExtendedKeyMap{
+		Universal: m.universalKeys,
+		ViewKeys:  helpKeys.ShortHelp(),
+		ViewFull:  helpKeys.FullHelp(),
+	}
+
+	s += "\n" + m.help.View(extendedKeys)
+	return s
+}
@@ -177,6 +177,13 @@ func (m *UpdateProgress) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case UpdateAnimeSelection:
+			// While the list is taking a search or filter, every key is the list's
+			if m.animeList != nil && m.animeList.Typing() {
+				var cmd tea.Cmd
+				_, cmd = m.animeList.Update(msg)
+				return m, cmd
+			}
+
 			// Handle back navigation
 			if msg.String() == "ctrl+c" || msg.String() == "esc" || msg.String() == "q" || msg.String() == "backspace" {
 				m.state = UpdateTypeSelection
@@ -226,7 +233,7 @@ func (m *UpdateProgress) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			default: // UpdateEpisode or UpdateScore
 				switch msg.String() {
-				case "ctrl+c", "esc", "q":
+				case "esc":
 					return m, func() tea.Msg { return BackMsg{} }
 
 				case "backspace":
@@ -246,10 +253,11 @@ func (m *UpdateProgress) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 				default:
 					// Accept numeric input and decimal point for score
-					if (msg.String() >= "0" && msg.String() <= "9") ||
-						(msg.String() == "." && m.updateType == UpdateScore) {
-						m.inputValue += msg.String()
+					extra := ""
+					if m.updateType == UpdateScore {
+						extra = "."
 					}
+					m.inputValue = appendDigits(m.inputValue, msg, extra)
 				}
 			}
 
@@ -265,7 +273,7 @@ func (m *UpdateProgress) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.successMsg = msg.Message
 			m.err = nil
 			// Trigger background cache refresh after successful update
-			// Use ForceRefreshCacheInBackground to bypass 5-minute check
+			// Use ForceRefreshCacheInBackground to bypass the cache_refresh_minutes check
 			if m.client != nil && !m.cfg.AniList.NoAniList {
 				ForceRefreshCacheInBackground(m.cfg, m.client)
 			}
@@ -1,18 +1,41 @@
 package utils
 
-// CompletionThreshold is the percentage at which an episode is considered complete
-const CompletionThreshold = 95.0
+// Watch thresholds in percent, overridable from config with SetThresholds
+var (
+	// completionThreshold is where an episode counts as watched for AniList progress and autoplay
+	completionThreshold = 85.0
+	// nextEpisodeThreshold is where continue watching moves on to the next episode instead of resuming
+	nextEpisodeThreshold = 95.0
+)
 
-// IsEpisodeComplete returns true if the episode playback percentage is above the completion threshold
+// SetThresholds sets the completion and next-episode thresholds (percentages)
+func SetThresholds(completion, nextEpisode int) {
+	completionThreshold = float64(completion)
+	nextEpisodeThreshold = float64(nextEpisode)
+}
+
+// IsEpisodeWatched returns true if enough of the episode was played to count it as watched
+func IsEpisodeWatched(percentageProgress float64) bool {
+	return percentageProgress >= completionThreshold
+}
+
+// IsEpisodeComplete returns true if the episode playback percentage is above the next-episode threshold
 func IsEpisodeComplete(percentageProgress float64) bool {
-	return percentageProgress >= CompletionThreshold
+	return percentageProgress >= nextEpisodeThreshold
 }
 
 // GetNextEpisode returns the next episode number based on completion status
-// If the current episode is complete (>= 95%), returns the next episode
+// If the current episode is complete (past the next-episode threshold), returns the next episode
 // Otherwise, returns the current episode for resuming
+// A totalEpisodes of 0 means the count is unknown, so there is always a next episode
+// Episode 0 is a show started before any progress was recorded, which is episode 1
 func GetNextEpisode(currentEpisode, totalEpisodes int, percentageProgress float64) int {
-	if IsEpisodeComplete(percentageProgress) && currentEpisode < totalEpisodes {
+	currentEpisode = max(currentEpisode, 1)
+	if totalEpisodes > 0 && currentEpisode > totalEpisodes {
+		// History can outrun an episode count AniList later lowered; stay on the final episode
+		return totalEpisodes
+	}
+	if IsEpisodeComplete(percentageProgress) && (totalEpisodes == 0 || currentEpisode < totalEpisodes) {
 		return currentEpisode + 1
 	}
 	return currentEpisode
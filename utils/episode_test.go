@@ -0,0 +1,28 @@
+package utils
+
+import "testing"
+
+func TestGetNextEpisode(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    int
+		total      int
+		percentage float64
+		want       int
+	}{
+		{"no progress yet", 0, 12, 0, 1},
+		{"no progress, unknown count", 0, 0, 0, 1},
+		{"episode not complete", 3, 12, 50, 3},
+		{"episode complete", 3, 12, 100, 4},
+		{"complete with unknown count", 3, 0, 100, 4},
+		{"final episode complete", 12, 12, 100, 12},
+		{"past the episode count", 14, 12, 0, 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetNextEpisode(tt.current, tt.total, tt.percentage); got != tt.want {
+				t.Errorf("GetNextEpisode(%d, %d, %v) = %d, want %d", tt.current, tt.total, tt.percentage, got, tt.want)
+			}
+		})
+	}
+}
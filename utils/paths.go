@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// appDirName is the directory oni uses under each XDG base directory
+const appDirName = "oni"
+
+// LegacyDir returns ~/.oni, where oni kept all of its files before following XDG
+func LegacyDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".oni"), nil
+}
+
+// dataDirOverride is the data_dir setting, which moves the data and cache directories when set
+var dataDirOverride string
+
+// SetDataDir makes DataDir and CacheDir resolve under dir; an empty dir restores the XDG locations
+func SetDataDir(dir string) {
+	dataDirOverride = dir
+}
+
+// ConfigDir returns $XDG_CONFIG_HOME/oni, or ~/.oni when XDG_CONFIG_HOME is unset
+func ConfigDir() (string, error) {
+	return resolveDir("XDG_CONFIG_HOME", "")
+}
+
+// DataDir returns data_dir when set, else $XDG_DATA_HOME/oni, or ~/.oni when XDG_DATA_HOME is unset
+func DataDir() (string, error) {
+	if dataDirOverride != "" {
+		return ensureDir(dataDirOverride)
+	}
+	return resolveDir("XDG_DATA_HOME", "")
+}
+
+// CacheDir returns data_dir/cache when data_dir is set, else $XDG_CACHE_HOME/oni, or ~/.oni/cache
+// when XDG_CACHE_HOME is unset
+func CacheDir() (string, error) {
+	if dataDirOverride != "" {
+		return ensureDir(filepath.Join(dataDirOverride, "cache"))
+	}
+	return resolveDir("XDG_CACHE_HOME", "cache")
+}
+
+// resolveDir resolves and creates an XDG directory, falling back to a directory under ~/.oni
+func resolveDir(envVar string, legacySubdir string) (string, error) {
+	var dir string
+	// The spec says relative paths are invalid and should be ignored
+	if base := os.Getenv(envVar); base != "" && filepath.IsAbs(base) {
+		dir = filepath.Join(base, appDirName)
+	} else {
+		legacy, err := LegacyDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(legacy, legacySubdir)
+	}
+	return ensureDir(dir)
+}
+
+// ensureDir creates dir if it doesn't exist yet and returns it
+func ensureDir(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+// MigrateLegacyFile moves a file from ~/.oni to its new location if it only exists at the old one
+// legacyName is relative to ~/.oni
+func MigrateLegacyFile(newPath string, legacyName string) error {
+	legacy, err := LegacyDir()
+	if err != nil {
+		return err
+	}
+
+	legacyPath := filepath.Join(legacy, legacyName)
+	if legacyPath == newPath {
+		return nil
+	}
+	if _, err := os.Stat(newPath); err == nil {
+		return nil
+	}
+	if _, err := os.Stat(legacyPath); err != nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(newPath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.Rename(legacyPath, newPath); err != nil {
+		return fmt.Errorf("failed to move %s to %s: %w", legacyPath, newPath, err)
+	}
+	return nil
+}
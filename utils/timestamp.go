@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTimestamp converts an HH:MM:SS timestamp into seconds
+// Returns false if the timestamp is empty or malformed
+func ParseTimestamp(timestamp string) (int, bool) {
+	parts := strings.Split(timestamp, ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, false
+	}
+
+	return hours*3600 + minutes*60 + seconds, true
+}
+
+// FormatTimestamp converts seconds into an HH:MM:SS timestamp
+func FormatTimestamp(seconds int) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+}
+
+// FormatWatchTime renders an estimated watch time in minutes, e.g. "≈ 5h 12m" or "≈ 45m"
+func FormatWatchTime(minutes int) string {
+	if minutes < 60 {
+		return fmt.Sprintf("≈ %dm", minutes)
+	}
+	return fmt.Sprintf("≈ %dh %dm", minutes/60, minutes%60)
+}
+
+// FormatAgo describes how long ago t was, e.g. "just now", "5m ago" or "2h ago"
+func FormatAgo(t time.Time) string {
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return strconv.Itoa(int(d.Minutes())) + "m ago"
+	case d < 24*time.Hour:
+		return strconv.Itoa(int(d.Hours())) + "h ago"
+	default:
+		return strconv.Itoa(int(d.Hours()/24)) + "d ago"
+	}
+}